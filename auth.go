@@ -0,0 +1,91 @@
+package bnet
+
+import (
+	"context"
+	"errors"
+)
+
+// UserTokenSource provides OAuth access tokens for Bungie.net users.
+// The oauth package provides an implementation.
+type UserTokenSource interface {
+	// AccessToken returns a valid access token for the user.
+	AccessToken(ctx context.Context, membershipID Int64) (string, error)
+
+	// RefreshAccessToken returns a new access token for the user after stale was rejected.
+	RefreshAccessToken(ctx context.Context, membershipID Int64, stale string) (string, error)
+}
+
+// WithUserTokens sets the source of access tokens used by ForUser.
+func (a *API) WithUserTokens(src UserTokenSource) *API {
+	new := *a
+	new.users = src
+	return &new
+}
+
+// ForUser returns an API that acts on behalf of the user with the given Bungie.net membership ID,
+// using tokens from the source set by WithUserTokens. If Bungie reports that the access token has
+// expired, it is refreshed and the request is retried once.
+func (a *API) ForUser(membershipID Int64) *API {
+	users := a.users
+	return a.WithInterceptorFunc(func(base Client, ctx context.Context, r ClientRequest, resp any) error {
+		if users == nil {
+			return errors.New("bnet: ForUser requires WithUserTokens")
+		}
+		return DoAuthorized(ctx, base, r, resp,
+			func(ctx context.Context) (string, error) {
+				return users.AccessToken(ctx, membershipID)
+			},
+			func(ctx context.Context, stale string) (string, error) {
+				return users.RefreshAccessToken(ctx, membershipID, stale)
+			})
+	})
+}
+
+// DoAuthorized sends r to base with the access token returned by token. If Bungie reports that the
+// token has expired, refresh is called with the rejected token, resp is reset, and the request is
+// retried once with the new token. It is meant for interceptors that manage their own tokens.
+func DoAuthorized(ctx context.Context, base Client, r ClientRequest, resp any,
+	token func(ctx context.Context) (string, error),
+	refresh func(ctx context.Context, stale string) (string, error),
+) error {
+	tok, err := token(ctx)
+	if err != nil {
+		return err
+	}
+	err = base.Do(ctx, withBearer(r, tok), resp)
+	if !IsAuthError(err) {
+		return err
+	}
+	tok, rErr := refresh(ctx, tok)
+	if rErr != nil {
+		return err
+	}
+	resetResponse(resp)
+	return base.Do(ctx, withBearer(r, tok), resp)
+}
+
+// withBearer returns a copy of r authorized with the access token tok.
+func withBearer(r ClientRequest, tok string) ClientRequest {
+	headers := make(map[string]string, len(r.Headers)+1)
+	for k, v := range r.Headers {
+		headers[k] = v
+	}
+	headers["Authorization"] = "Bearer " + tok
+	r.Headers = headers
+	return r
+}
+
+// IsAuthError reports whether err means that the access token is missing or has expired.
+func IsAuthError(err error) bool {
+	var bErr *BungieError
+	if !errors.As(err, &bErr) {
+		return false
+	}
+	switch bErr.Code {
+	case PlatformErrorCodes_WebAuthRequired,
+		PlatformErrorCodes_AccessTokenHasExpired,
+		PlatformErrorCodes_OAuthAccessTokenExpired:
+		return true
+	}
+	return false
+}
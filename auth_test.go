@@ -0,0 +1,48 @@
+package bnet
+
+import (
+	"context"
+	"testing"
+)
+
+type fakeUserTokens struct {
+	stale []string
+}
+
+func (f *fakeUserTokens) AccessToken(ctx context.Context, membershipID Int64) (string, error) {
+	return "at0", nil
+}
+
+func (f *fakeUserTokens) RefreshAccessToken(ctx context.Context, membershipID Int64, stale string) (string, error) {
+	f.stale = append(f.stale, stale)
+	return "at1", nil
+}
+
+func TestForUser(t *testing.T) {
+	var headers []string
+	users := &fakeUserTokens{}
+	api := (&API{}).WithInterceptorFunc(func(_ Client, ctx context.Context, r ClientRequest, resp any) error {
+		headers = append(headers, r.Headers["Authorization"])
+		sr := resp.(*ServerResponse[Manifest])
+		if r.Headers["Authorization"] != "Bearer at1" {
+			sr.MessageData = map[string]string{"stale": "yes"}
+			return &BungieError{Code: PlatformErrorCodes_AccessTokenHasExpired}
+		}
+		sr.Response.Version = "v1"
+		return nil
+	}).WithUserTokens(users).ForUser(42)
+
+	resp, err := api.Destiny2GetDestinyManifest(context.Background(), Destiny2GetDestinyManifestRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(users.stale) != 1 || users.stale[0] != "at0" {
+		t.Fatalf("refreshed %q; want at0", users.stale)
+	}
+	if len(headers) != 2 || headers[1] != "Bearer at1" {
+		t.Fatalf("headers = %q", headers)
+	}
+	if resp.Response.Version != "v1" || resp.MessageData != nil {
+		t.Fatalf("resp = %+v; want only the retried response", resp)
+	}
+}
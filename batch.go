@@ -0,0 +1,82 @@
+package bnet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrDefNotFound is returned by a DefSource that has no definition for a hash.
+var ErrDefNotFound = errors.New("missing entry")
+
+// BatchDefSource is a DefSource that can look up many definitions of a table at once.
+type BatchDefSource interface {
+	DefSource
+
+	// GetDefs decodes the definition of every hash in table into the value returned by alloc for it.
+	// Hashes without a definition are returned in missing.
+	GetDefs(ctx context.Context, table string, hashes []uint32, alloc func(hash uint32) any) (missing []uint32, err error)
+}
+
+// MissingDefsError is returned by GetMany when some hashes have no definition.
+type MissingDefsError struct {
+	Table  string
+	Hashes []uint32
+}
+
+func (err *MissingDefsError) Error() string {
+	return fmt.Sprintf("%d missing entries in %s", len(err.Hashes), err.Table)
+}
+
+func (err *MissingDefsError) Unwrap() error {
+	return ErrDefNotFound
+}
+
+// GetMany looks up the definitions of hashes. Duplicate hashes are looked up once.
+// If some hashes have no definition, the others are still returned along with a *MissingDefsError.
+func GetMany[T defTable](ctx context.Context, defs DefSource, hashes ...Hash[T]) (map[Hash[T]]*T, error) {
+	var zero T
+	table := zero.DefinitionTable()
+	out := make(map[Hash[T]]*T, len(hashes))
+	var unique []uint32
+	seen := make(map[Hash[T]]bool, len(hashes))
+	for _, h := range hashes {
+		if !seen[h] {
+			seen[h] = true
+			unique = append(unique, uint32(h))
+		}
+	}
+
+	var missing []uint32
+	if bd, ok := defs.(BatchDefSource); ok {
+		var err error
+		missing, err = bd.GetDefs(ctx, table, unique, func(hash uint32) any {
+			t := new(T)
+			out[Hash[T](hash)] = t
+			return t
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, h := range missing {
+			delete(out, Hash[T](h))
+		}
+	} else {
+		for _, h := range unique {
+			t := new(T)
+			err := getDef(ctx, defs, table, h, t)
+			if errors.Is(err, ErrDefNotFound) {
+				missing = append(missing, h)
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			out[Hash[T](h)] = t
+		}
+	}
+	if len(missing) > 0 {
+		return out, &MissingDefsError{Table: table, Hashes: missing}
+	}
+	return out, nil
+}
@@ -0,0 +1,87 @@
+package bnet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type mapDefs map[uint32]string
+
+func (m mapDefs) GetDef(table string, hash uint32, out any) error {
+	def, ok := m[hash]
+	if !ok {
+		return ErrDefNotFound
+	}
+	return json.Unmarshal([]byte(def), out)
+}
+
+type batchDefs struct {
+	mapDefs
+	calls int
+}
+
+func (b *batchDefs) GetDefs(ctx context.Context, table string, hashes []uint32, alloc func(uint32) any) ([]uint32, error) {
+	b.calls++
+	var missing []uint32
+	for _, h := range hashes {
+		def, ok := b.mapDefs[h]
+		if !ok {
+			missing = append(missing, h)
+			continue
+		}
+		if err := json.Unmarshal([]byte(def), alloc(h)); err != nil {
+			return nil, err
+		}
+	}
+	return missing, nil
+}
+
+func TestGetMany(t *testing.T) {
+	m := mapDefs{1: `{"hash":1,"index":10}`, 2: `{"hash":2,"index":20}`}
+	batch := &batchDefs{mapDefs: m}
+	for _, src := range []DefSource{m, batch} {
+		got, err := GetMany[ClassDefinition](context.Background(), src, 1, 2, 2, 3)
+		var missErr *MissingDefsError
+		if !errors.As(err, &missErr) || len(missErr.Hashes) != 1 || missErr.Hashes[0] != 3 {
+			t.Fatalf("%T: err = %v; want 3 missing", src, err)
+		}
+		if !errors.Is(err, ErrDefNotFound) {
+			t.Fatalf("%T: err = %v; want ErrDefNotFound", src, err)
+		}
+		if len(got) != 2 || got[1].Index != 10 || got[2].Index != 20 {
+			t.Fatalf("%T: got %v", src, got)
+		}
+	}
+	if batch.calls != 1 {
+		t.Fatalf("batch calls = %d; want 1", batch.calls)
+	}
+}
+
+func TestGetManyAPI(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/Platform/Destiny2/Manifest/DestinyClassDefinition/1/":
+			fmt.Fprint(w, `{"ErrorCode":1,"ErrorStatus":"Success","Response":{"hash":1,"index":10}}`)
+		case "/Platform/Destiny2/Manifest/DestinyClassDefinition/2/":
+			fmt.Fprint(w, `{"ErrorCode":1623,"ErrorStatus":"DestinyItemNotFound"}`)
+		default:
+			fmt.Fprint(w, `{"ErrorCode":1,"ErrorStatus":"Success","Response":null}`)
+		}
+	}))
+	defer srv.Close()
+	api := NewAPI("key").WithBaseURL(srv.URL + "/Platform")
+
+	got, err := GetMany[ClassDefinition](context.Background(), api, 1, 2, 3)
+	var missErr *MissingDefsError
+	if !errors.As(err, &missErr) || len(missErr.Hashes) != 2 {
+		t.Fatalf("err = %v; want 2 and 3 missing", err)
+	}
+	if len(got) != 1 || got[1].Index != 10 {
+		t.Fatalf("got %v", got)
+	}
+}
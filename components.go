@@ -0,0 +1,279 @@
+package bnet
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ComponentState describes why a ComponentResponse does or does not hold data.
+type ComponentState int
+
+const (
+	// ComponentAvailable means the component was returned.
+	ComponentAvailable ComponentState = iota
+	// ComponentNotRequested means the component was absent from the response, usually because it
+	// was not in the Components of the request.
+	ComponentNotRequested
+	// ComponentPrivate means the player's privacy settings hide the component from the caller.
+	ComponentPrivate
+	// ComponentDisabled means Bungie has temporarily disabled the component.
+	ComponentDisabled
+)
+
+func (s ComponentState) String() string {
+	switch s {
+	case ComponentAvailable:
+		return "available"
+	case ComponentNotRequested:
+		return "not requested"
+	case ComponentPrivate:
+		return "private"
+	case ComponentDisabled:
+		return "disabled"
+	}
+	return fmt.Sprintf("ComponentState(%d)", int(s))
+}
+
+var (
+	ErrComponentNotRequested = errors.New("bnet: component not requested")
+	ErrComponentPrivate      = errors.New("bnet: component is private")
+	ErrComponentDisabled     = errors.New("bnet: component is disabled")
+)
+
+// State reports whether c holds data, and if not, why.
+func (c ComponentResponse[T]) State() ComponentState {
+	if disabled, _ := c.Disabled.Value(); disabled {
+		return ComponentDisabled
+	}
+	switch c.Privacy {
+	case ComponentPrivacySetting_None:
+		// Every returned component has a privacy setting, so this one was left out.
+		return ComponentNotRequested
+	case ComponentPrivacySetting_Private:
+		// Private components are still returned to the owner.
+		if reflect.ValueOf(&c.Data).Elem().IsZero() {
+			return ComponentPrivate
+		}
+	}
+	return ComponentAvailable
+}
+
+// Ok reports whether c holds data.
+func (c ComponentResponse[T]) Ok() bool {
+	return c.State() == ComponentAvailable
+}
+
+// Err returns ErrComponentNotRequested, ErrComponentPrivate or ErrComponentDisabled if c holds no
+// data, and nil otherwise.
+func (c ComponentResponse[T]) Err() error {
+	switch c.State() {
+	case ComponentNotRequested:
+		return ErrComponentNotRequested
+	case ComponentPrivate:
+		return ErrComponentPrivate
+	case ComponentDisabled:
+		return ErrComponentDisabled
+	}
+	return nil
+}
+
+// componentErrors implements the generated ComponentErrors methods. It returns the errors of the
+// components in the struct v that are private or disabled, keyed by dotted field path. Components
+// that were not requested are left out.
+func componentErrors(v any) map[string]error {
+	errs := make(map[string]error)
+	collectComponentErrors("", reflect.ValueOf(v), errs)
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+func collectComponentErrors(path string, v reflect.Value, errs map[string]error) {
+	switch v.Kind() {
+	case reflect.Struct:
+		if c, ok := v.Interface().(interface{ Err() error }); ok {
+			if err := c.Err(); err != nil && err != ErrComponentNotRequested {
+				errs[path] = err
+			}
+			return
+		}
+		if _, ok := v.Interface().(ComponentDependent); !ok {
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if f := v.Type().Field(i); f.IsExported() {
+				collectComponentErrors(join(path, f.Name), v.Field(i), errs)
+			}
+		}
+	case reflect.Map:
+		if !v.Type().Elem().Implements(reflect.TypeOf((*ComponentDependent)(nil)).Elem()) {
+			return
+		}
+		iter := v.MapRange()
+		for iter.Next() {
+			collectComponentErrors(join(path, fmt.Sprint(iter.Key())), iter.Value(), errs)
+		}
+	}
+}
+
+func join(path, elem string) string {
+	if path == "" {
+		return elem
+	}
+	return path + "." + elem
+}
+
+// ComponentSet is a list of components to request. It can be assigned to the Components field of
+// requests such as Destiny2GetProfileRequest.
+//
+//	req := bnet.Destiny2GetProfileRequest{
+//		Components: bnet.Components().Profiles().FullInventory(),
+//	}
+type ComponentSet []ComponentType
+
+// Components returns an empty ComponentSet.
+func Components() ComponentSet {
+	return nil
+}
+
+// With returns a copy of c with the given components added.
+func (c ComponentSet) With(components ...ComponentType) ComponentSet {
+	out := make(ComponentSet, len(c), len(c)+len(components))
+	copy(out, c)
+	for _, component := range components {
+		if !hasComponent(out, component) {
+			out = append(out, component)
+		}
+	}
+	return out
+}
+
+// FullInventory adds the components needed to list every item of a profile with its instance data,
+// stats, sockets, perks and objectives.
+func (c ComponentSet) FullInventory() ComponentSet {
+	return c.With(ComponentType_ProfileInventories, ComponentType_ProfileCurrencies,
+		ComponentType_CharacterInventories, ComponentType_CharacterEquipment,
+		ComponentType_ItemInstances, ComponentType_ItemStats, ComponentType_ItemSockets,
+		ComponentType_ItemPlugStates, ComponentType_ItemReusablePlugs, ComponentType_ItemPerks,
+		ComponentType_ItemObjectives)
+}
+
+// Collections adds the components for collectibles and the presentation nodes that organize them.
+func (c ComponentSet) Collections() ComponentSet {
+	return c.With(ComponentType_Collectibles, ComponentType_PresentationNodes)
+}
+
+// Triumphs adds the components for records and the presentation nodes that organize them.
+func (c ComponentSet) Triumphs() ComponentSet {
+	return c.With(ComponentType_Records, ComponentType_PresentationNodes)
+}
+
+// Loadouts adds the components for saved loadouts and the equipped items they refer to.
+func (c ComponentSet) Loadouts() ComponentSet {
+	return c.With(ComponentType_CharacterLoadouts, ComponentType_CharacterEquipment,
+		ComponentType_ItemInstances, ComponentType_ItemSockets)
+}
+
+// ValidFor returns an error if c is empty or contains components that the operation cannot return,
+// according to OperationComponents.
+//
+//	err := bnet.Components().FullInventory().ValidFor("Destiny2.GetProfile")
+func (c ComponentSet) ValidFor(operation string) error {
+	valid, ok := OperationComponents[operation]
+	if !ok {
+		return fmt.Errorf("bnet: %s does not take components", operation)
+	}
+	if len(c) == 0 {
+		return fmt.Errorf("bnet: %s requires at least one component", operation)
+	}
+	var invalid []string
+	for _, component := range c {
+		if !hasComponent(valid, component) {
+			invalid = append(invalid, component.Enum())
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("bnet: %s does not return components %s", operation, strings.Join(invalid, ", "))
+	}
+	return nil
+}
+
+// ComponentDependent is implemented by response types whose fields are only populated when the
+// matching ComponentType is requested, such as ProfileResponse.
+type ComponentDependent interface {
+	ComponentDependencies() map[string]ComponentType
+}
+
+// MissingComponentsError is returned by CheckComponents.
+type MissingComponentsError struct {
+	Type string
+
+	// Missing maps each field to the ComponentType that was not requested.
+	Missing map[string]ComponentType
+}
+
+func (err *MissingComponentsError) Error() string {
+	var parts []string
+	for _, field := range orderedFields(err.Missing) {
+		parts = append(parts, fmt.Sprintf("%s requires component %s", field, err.Missing[field].Enum()))
+	}
+	return fmt.Sprintf("bnet: %s: %s", err.Type, strings.Join(parts, ", "))
+}
+
+// CheckComponents reports whether the named fields of resp will be populated by a request for the
+// given components. Reading a field whose component was not requested silently yields empty data.
+//
+//	req := bnet.Destiny2GetProfileRequest{Components: []bnet.ComponentType{bnet.ComponentType_Profiles}}
+//	err := bnet.CheckComponents(bnet.ProfileResponse{}, req.Components, "CharacterEquipment")
+//	// err: bnet: ProfileResponse: CharacterEquipment requires component CharacterEquipment
+func CheckComponents(resp ComponentDependent, requested []ComponentType, fields ...string) error {
+	t := reflect.TypeOf(resp)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	name := t.Name()
+	if i := strings.Index(name, "["); i >= 0 {
+		name = name[:i]
+	}
+	deps := resp.ComponentDependencies()
+	var missing map[string]ComponentType
+	for _, field := range fields {
+		if _, ok := t.FieldByName(field); !ok {
+			return fmt.Errorf("bnet: %s has no field %s", name, field)
+		}
+		dep, ok := deps[field]
+		if !ok || hasComponent(requested, dep) {
+			continue
+		}
+		if missing == nil {
+			missing = make(map[string]ComponentType)
+		}
+		missing[field] = dep
+	}
+	if missing != nil {
+		return &MissingComponentsError{Type: name, Missing: missing}
+	}
+	return nil
+}
+
+func hasComponent(components []ComponentType, c ComponentType) bool {
+	for _, have := range components {
+		if have == c {
+			return true
+		}
+	}
+	return false
+}
+
+func orderedFields(m map[string]ComponentType) []string {
+	fields := make([]string, 0, len(m))
+	for field := range m {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
@@ -0,0 +1,93 @@
+package bnet
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestCheckComponents(t *testing.T) {
+	requested := []ComponentType{ComponentType_Profiles, ComponentType_ItemStats}
+	if err := CheckComponents(ProfileResponse{}, requested, "Profile", "ResponseMintedTimestamp"); err != nil {
+		t.Fatal(err)
+	}
+	err := CheckComponents(&ProfileResponse{}, requested, "Profile", "CharacterEquipment")
+	var mErr *MissingComponentsError
+	if !errors.As(err, &mErr) || mErr.Missing["CharacterEquipment"] != ComponentType_CharacterEquipment {
+		t.Fatalf("err = %v", err)
+	}
+	if got, want := err.Error(), "bnet: ProfileResponse: CharacterEquipment requires component CharacterEquipment"; got != want {
+		t.Fatalf("err = %q; want %q", got, want)
+	}
+	if err := CheckComponents(ItemComponentSet[int64]{}, requested, "Stats"); err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckComponents(ProfileResponse{}, requested, "Equipment"); err == nil {
+		t.Fatal("want error for unknown field")
+	}
+}
+
+func TestComponentSet(t *testing.T) {
+	base := Components().Profiles()
+	c := base.FullInventory().Loadouts().ItemStats()
+	if len(base) != 1 {
+		t.Fatalf("base modified: %v", base)
+	}
+	if err := c.ValidFor("Destiny2.GetProfile"); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.ValidFor("Destiny2.GetItem"); err == nil {
+		t.Fatal("want error for components GetItem does not return")
+	}
+	if err := Components().ValidFor("Destiny2.GetProfile"); err == nil {
+		t.Fatal("want error for no components")
+	}
+	req := Destiny2GetProfileRequest{Components: c}
+	if n := len(req.Components); n != 13 {
+		t.Fatalf("len = %d; want 13", n)
+	}
+}
+
+func TestComponentState(t *testing.T) {
+	var resp ProfileResponse
+	err := json.Unmarshal([]byte(`{
+		"profile": {"data": {"characterIds": ["1"]}, "privacy": 1},
+		"profileInventory": {"privacy": 2},
+		"characterEquipment": {"data": {}, "privacy": 1, "disabled": true},
+		"itemComponents": {"sockets": {"privacy": 2}}
+	}`), &resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		got  ComponentState
+		want ComponentState
+	}{
+		{resp.Profile.State(), ComponentAvailable},
+		{resp.ProfileInventory.State(), ComponentPrivate},
+		{resp.CharacterEquipment.State(), ComponentDisabled},
+		{resp.Characters.State(), ComponentNotRequested},
+	} {
+		if tt.got != tt.want {
+			t.Errorf("state = %v; want %v", tt.got, tt.want)
+		}
+	}
+	if !resp.Profile.Ok() || resp.Characters.Err() != ErrComponentNotRequested {
+		t.Error("wrong Ok or Err")
+	}
+
+	errs := resp.ComponentErrors()
+	want := map[string]error{
+		"ProfileInventory":       ErrComponentPrivate,
+		"CharacterEquipment":     ErrComponentDisabled,
+		"ItemComponents.Sockets": ErrComponentPrivate,
+	}
+	if len(errs) != len(want) {
+		t.Fatalf("errs = %v; want %v", errs, want)
+	}
+	for field, err := range want {
+		if errs[field] != err {
+			t.Errorf("errs[%s] = %v; want %v", field, errs[field], err)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"debug/buildinfo"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -15,22 +16,40 @@ import (
 )
 
 type API struct {
-	client      Client
-	UserAgent   string
-	Application string
+	client         Client
+	httpClient     *http.Client
+	users          UserTokenSource
+	skipValidation bool
+	UserAgent      string
+	Application    string
 }
 
 func NewAPI(apiKey string) *API {
+	return NewAPIWithHTTPClient(apiKey, http.DefaultClient)
+}
+
+// NewAPIWithHTTPClient is like NewAPI, but sends requests with h.
+func NewAPIWithHTTPClient(apiKey string, h *http.Client) *API {
 	return (&API{
 		client: &defaultClient{
-			h:       http.DefaultClient,
+			h:       h,
 			baseURL: "https://www.bungie.net/Platform",
 			apiKey:  apiKey,
 		},
-		UserAgent: "bungie-api-go/" + Version(),
+		httpClient: h,
+		UserAgent:  "bungie-api-go/" + Version(),
 	})
 }
 
+// HTTPClient returns the HTTP client that the API was created with.
+// It is http.DefaultClient unless NewAPIWithHTTPClient was used.
+func (a *API) HTTPClient() *http.Client {
+	if a.httpClient == nil {
+		return http.DefaultClient
+	}
+	return a.httpClient
+}
+
 // GetClient returns the API client. You probably don't want to use this.
 func (a *API) GetClient() Client {
 	return a.client
@@ -129,6 +148,7 @@ func (c *defaultClient) Do(ctx context.Context, r ClientRequest, resp any) error
 	for k, v := range r.Headers {
 		req.Header.Set(k, v)
 	}
+	start := time.Now()
 	hResp, err := c.h.Do(req)
 	if err != nil {
 		return err
@@ -142,15 +162,22 @@ func (c *defaultClient) Do(ctx context.Context, r ClientRequest, resp any) error
 
 	serverResponse, ok := resp.(interface {
 		setRaw([]byte)
+		setMeta(ResponseMeta)
 		asError() error
 	})
 	if ok {
 		serverResponse.setRaw(bodyBytes)
+		serverResponse.setMeta(ResponseMeta{
+			StatusCode: hResp.StatusCode,
+			Header:     hResp.Header,
+			URL:        url,
+			Latency:    time.Since(start),
+		})
 	}
 
 	if err := json.Unmarshal(bodyBytes, &resp); err != nil {
 		if hResp.StatusCode > 299 {
-			return &HTTPError{Code: hResp.StatusCode, Status: hResp.Status, Body: bodyBytes}
+			return &HTTPError{Code: hResp.StatusCode, Status: hResp.Status, Header: hResp.Header, Body: bodyBytes}
 		}
 		return err
 	}
@@ -183,17 +210,42 @@ type ServerResponse[T any] struct {
 	MessageData        map[string]string
 	DetailedErrorTrace string
 
-	raw json.RawMessage
+	raw  json.RawMessage
+	meta ResponseMeta
+}
+
+// ResponseMeta describes the HTTP response that a ServerResponse was decoded from.
+type ResponseMeta struct {
+	StatusCode int
+
+	// Header holds the response headers, such as Retry-After, Cache-Control and X-SelfUrl.
+	Header http.Header
+
+	// URL is the request URL. It does not contain credentials, which are sent in headers.
+	URL string
+
+	// Latency is the time from sending the request until the whole response body was read.
+	Latency time.Duration
 }
 
 func (r ServerResponse[T]) Raw() []byte {
 	return r.raw
 }
 
+// Meta returns the HTTP metadata of the response. It is the zero value if the response did not come
+// from the default client, for example because a custom Client was installed with WithInterceptor.
+func (r ServerResponse[T]) Meta() ResponseMeta {
+	return r.meta
+}
+
 func (r *ServerResponse[T]) setRaw(b []byte) {
 	r.raw = b
 }
 
+func (r *ServerResponse[T]) setMeta(m ResponseMeta) {
+	r.meta = m
+}
+
 func (r *ServerResponse[T]) asError() error {
 	if r.ErrorCode == PlatformErrorCodes_Success {
 		return nil
@@ -225,6 +277,7 @@ func (err BungieError) Unwrap() error {
 type HTTPError struct {
 	Code   int
 	Status string
+	Header http.Header
 	Body   []byte
 }
 
@@ -320,32 +373,71 @@ type DefSource interface {
 	GetDef(table string, hash uint32, out any) error
 }
 
+// DefSourceContext is a DefSource whose lookups can be cancelled.
+type DefSourceContext interface {
+	DefSource
+	GetDefCtx(ctx context.Context, table string, hash uint32, out any) error
+}
+
+// getDef looks up a definition in defs, passing ctx along if defs supports it.
+func getDef(ctx context.Context, defs DefSource, table string, hash uint32, out any) error {
+	if dc, ok := defs.(DefSourceContext); ok {
+		return dc.GetDefCtx(ctx, table, hash, out)
+	}
+	return defs.GetDef(table, hash, out)
+}
+
 // func (h Hash[T]) Get(fetcher func(Hash[T]) (*T, error)) (*T, error) {
 // 	return fetcher(h)
 // }
 
 func (h Hash[T]) Get(defs DefSource) (*T, error) {
+	return h.GetCtx(context.Background(), defs)
+}
+
+// GetCtx is like Get, but the lookup is cancelled with ctx if defs implements DefSourceContext.
+func (h Hash[T]) GetCtx(ctx context.Context, defs DefSource) (*T, error) {
 	var t T
-	if err := defs.GetDef(t.DefinitionTable(), uint32(h), &t); err != nil {
+	if err := getDef(ctx, defs, t.DefinitionTable(), uint32(h), &t); err != nil {
 		return nil, err
 	}
 	return &t, nil
 }
 
 func (a API) GetDef(table string, hash uint32, out any) error {
-	ctx := context.Background()
+	return a.GetDefCtx(context.Background(), table, hash, out)
+}
+
+// GetDefCtx looks up a single definition with Destiny2GetDestinyEntityDefinition. It returns
+// ErrDefNotFound if Bungie has no definition for hash.
+func (a API) GetDefCtx(ctx context.Context, table string, hash uint32, out any) error {
 	def, err := a.Destiny2GetDestinyEntityDefinition(ctx, Destiny2GetDestinyEntityDefinitionRequest{
 		EntityType:     table,
 		HashIdentifier: hash,
 	})
+	var bErr *BungieError
+	if errors.As(err, &bErr) && defNotFoundCodes[bErr.Code] {
+		return ErrDefNotFound
+	}
 	if err != nil {
 		return err
 	}
 	r := struct {
-		Response any
+		Response json.RawMessage
 	}{}
-	r.Response = out
-	return json.Unmarshal(def.Raw(), &r)
+	if err := json.Unmarshal(def.Raw(), &r); err != nil {
+		return err
+	}
+	if len(r.Response) == 0 || string(r.Response) == "null" {
+		return ErrDefNotFound
+	}
+	return json.Unmarshal(r.Response, out)
+}
+
+// defNotFoundCodes are the errors of Destiny2GetDestinyEntityDefinition for a hash without a definition.
+var defNotFoundCodes = map[PlatformErrorCodes]bool{
+	PlatformErrorCodes_DestinyItemNotFound:        true,
+	PlatformErrorCodes_DestinyContentItemNotFound: true,
 }
 
 func (b BitmaskSet[T]) Has(value T) bool {
@@ -375,6 +467,7 @@ func (b BitmaskSet[T]) Clear(value T) BitmaskSet[T] {
 
 type BaseItemComponentSet[T comparable] struct {
 	Objectives ComponentResponse[map[T]ItemObjectivesComponent] `json:"objectives"`
+	Perks      ComponentResponse[map[T]ItemPerksComponent]      `json:"perks"`
 }
 
 type ItemComponentSet[T comparable] struct {
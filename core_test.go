@@ -1,8 +1,11 @@
 package bnet
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -33,3 +36,47 @@ func TestNullable(t *testing.T) {
 		t.Fatalf("want %s; got %s", want, got)
 	}
 }
+
+type ctxDefs struct{}
+
+func (ctxDefs) DefinitionTable() string { return "DestinyCtxDefinition" }
+
+func (ctxDefs) GetDef(table string, hash uint32, out any) error {
+	return fmt.Errorf("GetDef called")
+}
+
+func (ctxDefs) GetDefCtx(ctx context.Context, table string, hash uint32, out any) error {
+	return ctx.Err()
+}
+
+func TestHashGetCtx(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	if _, err := Hash[ctxDefs](1).GetCtx(ctx, ctxDefs{}); err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+	if _, err := Hash[ctxDefs](1).GetCtx(ctx, ctxDefs{}); err != context.Canceled {
+		t.Fatalf("err = %v; want context.Canceled", err)
+	}
+}
+
+func TestResponseMeta(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-SelfUrl", "https://www.bungie.net"+r.URL.Path)
+		fmt.Fprint(w, `{"ErrorCode":1,"Response":{}}`)
+	}))
+	defer srv.Close()
+	api := NewAPIWithHTTPClient("key", srv.Client()).WithBaseURL(srv.URL + "/Platform")
+
+	resp, err := api.Destiny2GetDestinyManifest(context.Background(), Destiny2GetDestinyManifestRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	meta := resp.Meta()
+	if meta.StatusCode != 200 || meta.URL != srv.URL+"/Platform/Destiny2/Manifest/" || meta.Latency <= 0 {
+		t.Fatalf("meta = %+v", meta)
+	}
+	if got := meta.Header.Get("X-SelfUrl"); got != "https://www.bungie.net/Platform/Destiny2/Manifest/" {
+		t.Fatalf("X-SelfUrl = %q", got)
+	}
+}
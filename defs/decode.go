@@ -0,0 +1,49 @@
+package defs
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// decodeTable decodes a definition table one entry at a time, so that the whole document is never
+// held in memory next to the decoded map.
+func decodeTable(r io.Reader) (map[uint32]json.RawMessage, error) {
+	dec := json.NewDecoder(r)
+	if err := expectDelim(dec, '{'); err != nil {
+		return nil, err
+	}
+	defs := make(map[uint32]json.RawMessage)
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		key, _ := tok.(string)
+		hash, err := strconv.ParseUint(key, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("bad definition hash %q", key)
+		}
+		var def json.RawMessage
+		if err := dec.Decode(&def); err != nil {
+			return nil, err
+		}
+		defs[uint32(hash)] = def
+	}
+	if err := expectDelim(dec, '}'); err != nil {
+		return nil, err
+	}
+	return defs, nil
+}
+
+func expectDelim(dec *json.Decoder, want json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok != want {
+		return fmt.Errorf("expected %v in definition table, got %v", want, tok)
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package defs
 
 import (
+	"bufio"
 	"context"
 	"encoding/json"
 	"errors"
@@ -8,6 +9,7 @@ import (
 	"io"
 	"net/http"
 	"sync"
+	"time"
 
 	bnet "github.com/d2orbc/bungie-api-go"
 )
@@ -20,56 +22,246 @@ func NewCacheWithLocale(api *bnet.API, locale string) *Cache {
 	if locale == "" {
 		locale = "en"
 	}
-	return &Cache{api: api, locale: locale, m: make(map[string]*cachedTable)}
+	return &Cache{
+		api:    api,
+		locale: locale,
+		m:      make(map[tableKey]*cachedTable),
+		shared: make(map[string]sharedTable),
+	}
+}
+
+// NewPinnedCache returns a Cache that serves the given manifest and never checks for updates.
+// Tables are still downloaded on demand, which works for older manifests too. Lookups fail if the
+// manifest has no version.
+func NewPinnedCache(manifest bnet.Manifest, locale string) *Cache {
+	c := NewCacheWithLocale(nil, locale)
+	c.manifest = manifest
+	c.pinned = true
+	return c
 }
 
 type Cache struct {
 	api    *bnet.API
 	locale string
 
+	// Dir, if set, is a directory where downloaded tables are kept across runs.
+	// Tables are stored per manifest version and locale, and reused while the version is current.
+	// Tables of other versions are removed, except by caches from NewPinnedCache, so a pinned cache
+	// needs a different Dir than one that follows the current manifest.
+	// It must be set before the first lookup.
+	Dir string
+
+	// HTTPClient is used to download tables. The default is the HTTP client of the API.
+	HTTPClient *http.Client
+
+	// ContentURL is prefixed to the content paths in the manifest. The default is DefaultContentURL.
+	ContentURL string
+
+	// Progress, if set, is called as tables are downloaded.
+	Progress ProgressFunc
+
+	// OnTableLoad, if set, is called after a table is read from disk or downloaded.
+	OnTableLoad func(TableLoad)
+
+	// StrictLocale makes lookups fail with ErrLocaleUnavailable when the manifest has no table for
+	// the requested locale, instead of falling back to "en".
+	StrictLocale bool
+
 	mu       sync.Mutex
 	manifest bnet.Manifest
-	m        map[string]*cachedTable
+	pinned   bool
+	offline  bool
+	m        map[tableKey]*cachedTable
+
+	// shared holds loaded tables by content path, so that locales with identical content share them.
+	// It has its own lock because it is used while a table is locked, and mu is locked before tables.
+	sharedMu sync.Mutex
+	shared   map[string]sharedTable
 }
 
+// TableLoad describes the loading of a definition table, for Cache.OnTableLoad.
+type TableLoad struct {
+	Locale string
+	Table  string
+
+	// Entries is the number of definitions in the table.
+	Entries int
+
+	// FromDisk is set if the table was read from Dir rather than downloaded.
+	FromDisk bool
+
+	Duration time.Duration
+	Err      error
+}
+
+// ErrLocaleUnavailable is returned when StrictLocale is set and a table is not available in the
+// requested locale.
+var ErrLocaleUnavailable = errors.New("definition table not available in locale")
+
+type tableKey struct {
+	locale string
+	table  string
+}
+
+type sharedTable struct {
+	version string
+	defs    map[uint32]json.RawMessage
+}
+
+var (
+	_ bnet.DefSourceContext = (*Cache)(nil)
+	_ bnet.BatchDefSource   = (*Cache)(nil)
+)
+
 type cachedTable struct {
 	mu      sync.Mutex
 	version string
 	defs    map[uint32]json.RawMessage
+
+	// fallback is set if the table was served in "en" instead of the requested locale.
+	fallback bool
 }
 
 func (c *Cache) GetDef(table string, hash uint32, out any) error {
-	ctx := context.Background()
-	if err := c.ensureTable(ctx, table); err != nil {
+	return c.GetDefCtx(context.Background(), table, hash, out)
+}
+
+func (c *Cache) GetDefCtx(ctx context.Context, table string, hash uint32, out any) error {
+	return c.getDef(ctx, c.locale, table, hash, out)
+}
+
+// GetDefs implements bnet.BatchDefSource. The table is locked once for the whole batch.
+func (c *Cache) GetDefs(ctx context.Context, table string, hashes []uint32, alloc func(hash uint32) any) ([]uint32, error) {
+	return c.getDefs(ctx, c.locale, table, hashes, alloc)
+}
+
+// UsedFallback reports whether table was served in "en" because the manifest has no table for the
+// cache's locale.
+func (c *Cache) UsedFallback(table string) bool {
+	return c.usedFallback(c.locale, table)
+}
+
+// Locale returns a view of c that serves definitions in locale. Views share the manifest, and
+// tables are shared between locales whose content is identical.
+func (c *Cache) Locale(locale string) *LocaleView {
+	return &LocaleView{c: c, locale: locale}
+}
+
+// LocaleView is a DefSource for one locale of a Cache.
+type LocaleView struct {
+	c      *Cache
+	locale string
+}
+
+var (
+	_ bnet.DefSourceContext = (*LocaleView)(nil)
+	_ bnet.BatchDefSource   = (*LocaleView)(nil)
+)
+
+func (v *LocaleView) GetDef(table string, hash uint32, out any) error {
+	return v.GetDefCtx(context.Background(), table, hash, out)
+}
+
+func (v *LocaleView) GetDefCtx(ctx context.Context, table string, hash uint32, out any) error {
+	return v.c.getDef(ctx, v.locale, table, hash, out)
+}
+
+func (v *LocaleView) GetDefs(ctx context.Context, table string, hashes []uint32, alloc func(hash uint32) any) ([]uint32, error) {
+	return v.c.getDefs(ctx, v.locale, table, hashes, alloc)
+}
+
+// Version returns the version of the current manifest, or "" if none has been loaded yet.
+func (v *LocaleView) Version() string {
+	return v.c.Version()
+}
+
+// UsedFallback reports whether table was served in "en" because the manifest has no table for the
+// view's locale.
+func (v *LocaleView) UsedFallback(table string) bool {
+	return v.c.usedFallback(v.locale, table)
+}
+
+func (c *Cache) usedFallback(locale, table string) bool {
+	c.mu.Lock()
+	t := c.m[tableKey{locale, table}]
+	c.mu.Unlock()
+	if t == nil {
+		return false
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.fallback
+}
+
+func (c *Cache) getDef(ctx context.Context, locale, table string, hash uint32, out any) error {
+	if err := c.ensureTable(ctx, locale, table); err != nil {
 		return err
 	}
 	c.mu.Lock()
-	t := c.m[table]
+	t := c.m[tableKey{locale, table}]
 	c.mu.Unlock()
 	t.mu.Lock()
 	def, ok := t.defs[hash]
 	t.mu.Unlock()
-	if !ok {
-		c.CheckUpdates(ctx)
+	if !ok && c.CheckUpdates(ctx) == nil && c.ensureTable(ctx, locale, table) == nil {
 		t.mu.Lock()
 		def, ok = t.defs[hash]
 		t.mu.Unlock()
 	}
 	if !ok {
-		return fmt.Errorf("missing entry")
+		return bnet.ErrDefNotFound
 	}
 	return json.Unmarshal(def, out)
 }
 
-func (c *Cache) ensureTable(ctx context.Context, table string) error {
+func (c *Cache) getDefs(ctx context.Context, locale, table string, hashes []uint32, alloc func(hash uint32) any) ([]uint32, error) {
+	if err := c.ensureTable(ctx, locale, table); err != nil {
+		return nil, err
+	}
+	found, missing := c.lookupAll(locale, table, hashes)
+	if len(missing) > 0 && c.CheckUpdates(ctx) == nil && c.ensureTable(ctx, locale, table) == nil {
+		found, missing = c.lookupAll(locale, table, hashes)
+	}
+	for i, h := range hashes {
+		if found[i] == nil {
+			continue
+		}
+		if err := json.Unmarshal(found[i], alloc(h)); err != nil {
+			return nil, err
+		}
+	}
+	return missing, nil
+}
+
+// lookupAll returns the raw definitions of hashes, in order, with nil for missing ones.
+func (c *Cache) lookupAll(locale, table string, hashes []uint32) (found []json.RawMessage, missing []uint32) {
+	c.mu.Lock()
+	t := c.m[tableKey{locale, table}]
+	c.mu.Unlock()
+	found = make([]json.RawMessage, len(hashes))
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for i, h := range hashes {
+		def, ok := t.defs[h]
+		if !ok {
+			missing = append(missing, h)
+			continue
+		}
+		found[i] = def
+	}
+	return found, missing
+}
+
+func (c *Cache) ensureTable(ctx context.Context, locale, table string) error {
 	if err := c.ensureManifest(ctx); err != nil {
 		return err
 	}
+	key := tableKey{locale, table}
 	c.mu.Lock()
-	if c.m[table] == nil {
-		c.m[table] = &cachedTable{}
+	if c.m[key] == nil {
+		c.m[key] = &cachedTable{}
 	}
-	t := c.m[table]
+	t := c.m[key]
 	t.mu.Lock()
 	defer t.mu.Unlock()
 	mani := c.manifest
@@ -78,35 +270,98 @@ func (c *Cache) ensureTable(ctx context.Context, table string) error {
 	if t.version == mani.Version {
 		return nil
 	}
-	path, ok := mani.JsonWorldComponentContentPaths[c.locale][table]
-	if !ok {
+	newDefs, fallback, err := c.loadTable(ctx, mani, locale, table)
+	if err != nil {
+		return err
+	}
+	t.defs = newDefs
+	t.version = mani.Version
+	t.fallback = fallback
+	return nil
+}
+
+// loadTable reads the definitions of table in locale for mani from memory, the disk cache, or the
+// network. fallback is set if the table was not available in locale and "en" was used instead.
+func (c *Cache) loadTable(ctx context.Context, mani bnet.Manifest, locale, table string) (defs map[uint32]json.RawMessage, fallback bool, err error) {
+	if c.offline {
+		return nil, false, fmt.Errorf("definition table %q is not in the snapshot", table)
+	}
+	path, ok := mani.JsonWorldComponentContentPaths[locale][table]
+	if !ok && locale != "en" {
+		if c.StrictLocale {
+			return nil, false, fmt.Errorf("%w: %s in %q", ErrLocaleUnavailable, table, locale)
+		}
 		// Fallback to "en" if requested locale is not available
-		path, ok = mani.JsonWorldComponentContentPaths["en"][table]
+		locale = "en"
+		fallback = true
+		path, ok = mani.JsonWorldComponentContentPaths[locale][table]
 	}
 	if !ok {
-		return fmt.Errorf("unknown definition table %q", table)
+		return nil, false, fmt.Errorf("unknown definition table %q", table)
+	}
+	c.sharedMu.Lock()
+	shared, ok := c.shared[path]
+	c.sharedMu.Unlock()
+	if ok && shared.version == mani.Version {
+		return shared.defs, fallback, nil
+	}
+	start := time.Now()
+	defs, fromDisk, err := c.fetchTable(ctx, mani.Version, locale, table, path)
+	if c.OnTableLoad != nil {
+		c.OnTableLoad(TableLoad{Locale: locale, Table: table, Entries: len(defs), FromDisk: fromDisk, Duration: time.Since(start), Err: err})
 	}
-	resp, err := http.Get("https://www.bungie.net/" + path)
 	if err != nil {
-		return err
+		return nil, false, err
 	}
-	if resp.StatusCode > 299 {
-		return errors.New(resp.Status)
+	c.sharedMu.Lock()
+	for p, s := range c.shared {
+		if s.version != mani.Version {
+			delete(c.shared, p)
+		}
+	}
+	c.shared[path] = sharedTable{version: mani.Version, defs: defs}
+	c.sharedMu.Unlock()
+	return defs, fallback, nil
+}
+
+// fetchTable reads a table from the disk cache, or downloads it from path.
+func (c *Cache) fetchTable(ctx context.Context, version, locale, table, path string) (defs map[uint32]json.RawMessage, fromDisk bool, err error) {
+	if diskDefs, ok := c.readDisk(version, locale, table); ok {
+		return diskDefs, true, nil
 	}
-	body, err := io.ReadAll(resp.Body)
+	rc, err := Download(ctx, c.HTTPClient, c.api, c.ContentURL, path, c.Progress)
 	if err != nil {
-		return err
+		return nil, false, err
 	}
-	var newDefs map[uint32]json.RawMessage
-	if err := json.Unmarshal(body, &newDefs); err != nil {
-		return err
+	defer rc.Close()
+	var body io.Reader = rc
+	// The disk cache is best effort; a failed write only costs a download next time.
+	tmp := c.diskTemp(version, locale, table)
+	if tmp != nil {
+		body = io.TeeReader(rc, tmp)
 	}
-	t.defs = newDefs
-	t.version = mani.Version
-	return nil
+	newDefs, err := decodeTable(bufio.NewReader(body))
+	if tmp != nil {
+		if err == nil {
+			c.commitDisk(tmp, version, locale, table)
+		} else {
+			abortDisk(tmp)
+		}
+	}
+	return newDefs, false, err
+}
+
+// Version returns the version of the current manifest, or "" if none has been loaded yet.
+func (c *Cache) Version() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.manifest.Version
 }
 
 func (c *Cache) CheckUpdates(ctx context.Context) error {
+	if c.pinned {
+		return nil
+	}
 	manifest, err := c.api.Destiny2GetDestinyManifest(ctx, bnet.Destiny2GetDestinyManifestRequest{})
 	if err != nil {
 		return err
@@ -120,6 +375,16 @@ func (c *Cache) CheckUpdates(ctx context.Context) error {
 	return nil
 }
 
+// Manifest returns the current manifest, fetching it if none has been loaded yet.
+func (c *Cache) Manifest(ctx context.Context) (bnet.Manifest, error) {
+	if err := c.ensureManifest(ctx); err != nil {
+		return bnet.Manifest{}, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.manifest, nil
+}
+
 // ensureManifest ensures that a manifest is loaded.
 func (c *Cache) ensureManifest(ctx context.Context) error {
 	c.mu.Lock()
@@ -127,6 +392,9 @@ func (c *Cache) ensureManifest(ctx context.Context) error {
 	if c.manifest.Version != "" {
 		return nil
 	}
+	if c.api == nil {
+		return errors.New("defs: no manifest loaded and no API to fetch one")
+	}
 	manifest, err := c.api.Destiny2GetDestinyManifest(ctx, bnet.Destiny2GetDestinyManifestRequest{})
 	if err != nil {
 		return err
@@ -0,0 +1,155 @@
+package defs
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	bnet "github.com/d2orbc/bungie-api-go"
+)
+
+func newTestServer(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/Platform/Destiny2/Manifest/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"ErrorCode":1,"Response":{"version":"v1","jsonWorldComponentContentPaths":{
+			"en":{"DestinyClassDefinition":"/content/en/DestinyClassDefinition-v1.json",
+				"DestinyRaceDefinition":"/content/en/DestinyRaceDefinition-v1.json"},
+			"de":{"DestinyClassDefinition":"/content/de/DestinyClassDefinition-v1.json"}}}}`)
+	})
+	mux.HandleFunc("/content/en/DestinyClassDefinition-v1.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"671679327":{"hash":671679327,"index":1,"displayProperties":{"name":"Hunter"}}}`)
+	})
+	mux.HandleFunc("/content/de/DestinyClassDefinition-v1.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"671679327":{"hash":671679327,"index":1,"displayProperties":{"name":"Jäger"}}}`)
+	})
+	mux.HandleFunc("/content/en/DestinyRaceDefinition-v1.json", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept-Encoding") != "gzip" {
+			fmt.Fprint(w, `{"898834093":{"hash":898834093,"index":2}}`)
+			return
+		}
+		w.Header().Set("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(w)
+		fmt.Fprint(gz, `{"898834093":{"hash":898834093,"index":2}}`)
+		gz.Close()
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestCache(t *testing.T) {
+	srv := newTestServer(t)
+	c := NewCache(bnet.NewAPI("key").WithBaseURL(srv.URL + "/Platform"))
+	c.HTTPClient = srv.Client()
+	c.ContentURL = srv.URL
+	c.Dir = t.TempDir()
+
+	def, err := bnet.Hash[bnet.ClassDefinition](671679327).GetCtx(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if def.Index != 1 {
+		t.Fatalf("def = %+v", def)
+	}
+	if _, err := bnet.Hash[bnet.ClassDefinition](1).Get(c); err != bnet.ErrDefNotFound {
+		t.Fatalf("err = %v; want ErrDefNotFound", err)
+	}
+	if _, ok := c.readDisk("v1", "en", "DestinyClassDefinition"); !ok {
+		t.Fatal("table not written to disk")
+	}
+}
+
+func TestCacheGzip(t *testing.T) {
+	srv := newTestServer(t)
+	c := NewCache(bnet.NewAPI("key").WithBaseURL(srv.URL + "/Platform"))
+	c.ContentURL = srv.URL
+	var progress []string
+	c.Progress = func(path string, read, total int64) {
+		progress = append(progress, fmt.Sprintf("%s %d/%d", path, read, total))
+	}
+
+	def, err := bnet.Hash[bnet.RaceDefinition](898834093).Get(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if def.Index != 2 {
+		t.Fatalf("def = %+v", def)
+	}
+	if len(progress) == 0 {
+		t.Fatal("no progress reported")
+	}
+}
+
+func TestCacheLocale(t *testing.T) {
+	srv := newTestServer(t)
+	c := NewCache(bnet.NewAPI("key").WithBaseURL(srv.URL + "/Platform"))
+	c.ContentURL = srv.URL
+	de := c.Locale("de")
+
+	hunter, err := bnet.Hash[bnet.ClassDefinition](671679327).Get(de)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := hunter.DisplayProperties.Name; got != "Jäger" {
+		t.Fatalf("name = %q; want Jäger", got)
+	}
+	if _, err := bnet.Hash[bnet.RaceDefinition](898834093).Get(de); err != nil {
+		t.Fatal(err)
+	}
+	if !de.UsedFallback("DestinyRaceDefinition") || de.UsedFallback("DestinyClassDefinition") {
+		t.Fatal("wrong fallback flags")
+	}
+
+	c.StrictLocale = true
+	if _, err := bnet.Hash[bnet.RaceDefinition](898834093).Get(c.Locale("fr")); !errors.Is(err, ErrLocaleUnavailable) {
+		t.Fatalf("err = %v; want ErrLocaleUnavailable", err)
+	}
+}
+
+func TestCacheConcurrentLoad(t *testing.T) {
+	srv := newTestServer(t)
+	release := make(chan struct{})
+	blocking := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		srv.Config.Handler.ServeHTTP(w, r)
+	}))
+	defer blocking.Close()
+	c := NewCache(bnet.NewAPI("key").WithBaseURL(srv.URL + "/Platform"))
+	c.ContentURL = blocking.URL
+	if _, err := c.Manifest(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	errs := make(chan error)
+	for i := 0; i < 2; i++ {
+		go func() {
+			_, err := bnet.Hash[bnet.ClassDefinition](671679327).Get(c)
+			errs <- err
+		}()
+	}
+	// Let both lookups wait on the download.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	for i := 0; i < 2; i++ {
+		select {
+		case err := <-errs:
+			if err != nil {
+				t.Fatal(err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("lookups deadlocked")
+		}
+	}
+}
+
+func TestPinnedCacheEmptyManifest(t *testing.T) {
+	c := NewPinnedCache(bnet.Manifest{}, "en")
+	if _, err := bnet.Hash[bnet.ClassDefinition](671679327).Get(c); err == nil {
+		t.Fatal("want error for a manifest without a version")
+	}
+}
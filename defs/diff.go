@@ -0,0 +1,248 @@
+package defs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	bnet "github.com/d2orbc/bungie-api-go"
+)
+
+// ManifestDiff is the difference between the definitions of two manifests.
+type ManifestDiff struct {
+	OldVersion string `json:"oldVersion"`
+	NewVersion string `json:"newVersion"`
+
+	// Tables holds the tables that changed, sorted by name.
+	Tables []TableDiff `json:"tables"`
+}
+
+// TableDiff is the difference between two versions of a definition table.
+type TableDiff struct {
+	Table    string    `json:"table"`
+	Added    []uint32  `json:"added,omitempty"`
+	Removed  []uint32  `json:"removed,omitempty"`
+	Modified []DefDiff `json:"modified,omitempty"`
+}
+
+// DefDiff lists the changed fields of a definition.
+type DefDiff struct {
+	Hash    uint32        `json:"hash"`
+	Changes []FieldChange `json:"changes"`
+}
+
+// FieldChange is a single changed value. Path is a dotted path into the definition, with array
+// indexes and map keys as elements, such as "displayProperties.name" or "perks.0.perkHash".
+// Old or New is nil if the value was added or removed.
+type FieldChange struct {
+	Path string          `json:"path"`
+	Old  json.RawMessage `json:"old,omitempty"`
+	New  json.RawMessage `json:"new,omitempty"`
+}
+
+// Diff compares tables between the manifests of old and new, typically a cache from NewPinnedCache
+// for a saved manifest and one for the current manifest. If no tables are given, every table in
+// either manifest is compared. A table that is missing from one of the manifests is compared as
+// empty, so all of its definitions are added or removed. Both caches keep the tables they load.
+func Diff(ctx context.Context, old, new *Cache, tables ...string) (*ManifestDiff, error) {
+	oldMani, err := old.Manifest(ctx)
+	if err != nil {
+		return nil, err
+	}
+	newMani, err := new.Manifest(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(tables) == 0 {
+		seen := make(map[string]bool)
+		for _, paths := range []map[string]string{
+			oldMani.JsonWorldComponentContentPaths[old.locale],
+			newMani.JsonWorldComponentContentPaths[new.locale],
+		} {
+			for table := range paths {
+				if !seen[table] {
+					seen[table] = true
+					tables = append(tables, table)
+				}
+			}
+		}
+		sort.Strings(tables)
+	}
+
+	d := &ManifestDiff{OldVersion: oldMani.Version, NewVersion: newMani.Version}
+	for _, table := range tables {
+		oldDefs, err := old.diffDefs(ctx, oldMani, table)
+		if err != nil {
+			return nil, err
+		}
+		newDefs, err := new.diffDefs(ctx, newMani, table)
+		if err != nil {
+			return nil, err
+		}
+		td, err := diffTable(table, oldDefs, newDefs)
+		if err != nil {
+			return nil, err
+		}
+		if len(td.Added)+len(td.Removed)+len(td.Modified) > 0 {
+			d.Tables = append(d.Tables, td)
+		}
+	}
+	return d, nil
+}
+
+// diffDefs returns all raw definitions of table, or none if mani doesn't have the table.
+func (c *Cache) diffDefs(ctx context.Context, mani bnet.Manifest, table string) (map[uint32]json.RawMessage, error) {
+	paths := mani.JsonWorldComponentContentPaths
+	if _, ok := paths[c.locale][table]; !ok {
+		if _, ok := paths["en"][table]; !ok {
+			return nil, nil
+		}
+	}
+	return c.table(ctx, table)
+}
+
+// table returns all raw definitions of table. The map must not be modified.
+func (c *Cache) table(ctx context.Context, table string) (map[uint32]json.RawMessage, error) {
+	if err := c.ensureTable(ctx, c.locale, table); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	t := c.m[tableKey{c.locale, table}]
+	c.mu.Unlock()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.defs, nil
+}
+
+func diffTable(table string, old, new map[uint32]json.RawMessage) (TableDiff, error) {
+	td := TableDiff{Table: table}
+	for hash, newDef := range new {
+		oldDef, ok := old[hash]
+		if !ok {
+			td.Added = append(td.Added, hash)
+			continue
+		}
+		if bytes.Equal(oldDef, newDef) {
+			continue
+		}
+		changes, err := diffJSON(oldDef, newDef)
+		if err != nil {
+			return td, fmt.Errorf("%s %d: %w", table, hash, err)
+		}
+		if len(changes) > 0 {
+			td.Modified = append(td.Modified, DefDiff{Hash: hash, Changes: changes})
+		}
+	}
+	for hash := range old {
+		if _, ok := new[hash]; !ok {
+			td.Removed = append(td.Removed, hash)
+		}
+	}
+	sort.Slice(td.Added, func(i, j int) bool { return td.Added[i] < td.Added[j] })
+	sort.Slice(td.Removed, func(i, j int) bool { return td.Removed[i] < td.Removed[j] })
+	sort.Slice(td.Modified, func(i, j int) bool { return td.Modified[i].Hash < td.Modified[j].Hash })
+	return td, nil
+}
+
+// diffJSON returns the field-level changes between two JSON documents.
+func diffJSON(old, new []byte) ([]FieldChange, error) {
+	oldVal, err := decodeJSON(old)
+	if err != nil {
+		return nil, err
+	}
+	newVal, err := decodeJSON(new)
+	if err != nil {
+		return nil, err
+	}
+	var changes []FieldChange
+	diffValues("", oldVal, newVal, &changes)
+	return changes, nil
+}
+
+func decodeJSON(b []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var v any
+	err := dec.Decode(&v)
+	return v, err
+}
+
+func diffValues(path string, old, new any, changes *[]FieldChange) {
+	switch o := old.(type) {
+	case map[string]any:
+		if n, ok := new.(map[string]any); ok {
+			for _, k := range unionKeys(o, n) {
+				ov, inOld := o[k]
+				nv, inNew := n[k]
+				switch {
+				case !inOld:
+					*changes = append(*changes, FieldChange{Path: join(path, k), New: mustMarshal(nv)})
+				case !inNew:
+					*changes = append(*changes, FieldChange{Path: join(path, k), Old: mustMarshal(ov)})
+				default:
+					diffValues(join(path, k), ov, nv, changes)
+				}
+			}
+			return
+		}
+	case []any:
+		if n, ok := new.([]any); ok {
+			for i := 0; i < len(o) || i < len(n); i++ {
+				p := join(path, strconv.Itoa(i))
+				switch {
+				case i >= len(o):
+					*changes = append(*changes, FieldChange{Path: p, New: mustMarshal(n[i])})
+				case i >= len(n):
+					*changes = append(*changes, FieldChange{Path: p, Old: mustMarshal(o[i])})
+				default:
+					diffValues(p, o[i], n[i], changes)
+				}
+			}
+			return
+		}
+	case json.Number:
+		if n, ok := new.(json.Number); ok {
+			of, oErr := o.Float64()
+			nf, nErr := n.Float64()
+			if oErr == nil && nErr == nil && of == nf {
+				return
+			}
+		}
+	}
+	oldJSON, newJSON := mustMarshal(old), mustMarshal(new)
+	if !bytes.Equal(oldJSON, newJSON) {
+		*changes = append(*changes, FieldChange{Path: path, Old: oldJSON, New: newJSON})
+	}
+}
+
+func unionKeys(a, b map[string]any) []string {
+	keys := make([]string, 0, len(a))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func join(path, elem string) string {
+	if path == "" {
+		return elem
+	}
+	return path + "." + elem
+}
+
+func mustMarshal(v any) json.RawMessage {
+	b, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
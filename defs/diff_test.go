@@ -0,0 +1,109 @@
+package defs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	bnet "github.com/d2orbc/bungie-api-go"
+)
+
+func TestDiffNewTable(t *testing.T) {
+	srv := newTestServer(t)
+	current := NewCache(bnet.NewAPI("key").WithBaseURL(srv.URL + "/Platform"))
+	current.ContentURL = srv.URL
+	// The old manifest predates DestinyRaceDefinition.
+	pinned := NewPinnedCache(bnet.Manifest{
+		Version: "v0",
+		JsonWorldComponentContentPaths: map[string]map[string]string{
+			"en": {"DestinyClassDefinition": "/content/en/DestinyClassDefinition-v1.json"},
+		},
+	}, "en")
+	pinned.ContentURL = srv.URL
+	ctx := context.Background()
+
+	d, err := Diff(ctx, pinned, current)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(d.Tables) != 1 || d.Tables[0].Table != "DestinyRaceDefinition" || len(d.Tables[0].Added) != 1 {
+		t.Fatalf("diff = %+v; want DestinyRaceDefinition added", d)
+	}
+
+	d, err = Diff(ctx, current, pinned)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(d.Tables) != 1 || len(d.Tables[0].Removed) != 1 {
+		t.Fatalf("diff = %+v; want DestinyRaceDefinition removed", d)
+	}
+}
+
+func TestDiffModified(t *testing.T) {
+	srv := newTestServer(t)
+	current := NewCache(bnet.NewAPI("key").WithBaseURL(srv.URL + "/Platform"))
+	current.ContentURL = srv.URL
+	// Use the German table as the old version of the English one.
+	pinned := NewPinnedCache(bnet.Manifest{
+		Version: "v0",
+		JsonWorldComponentContentPaths: map[string]map[string]string{
+			"en": {"DestinyClassDefinition": "/content/de/DestinyClassDefinition-v1.json"},
+		},
+	}, "en")
+	pinned.ContentURL = srv.URL
+
+	d, err := Diff(context.Background(), pinned, current, "DestinyClassDefinition")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(d.Tables) != 1 || len(d.Tables[0].Modified) != 1 {
+		t.Fatalf("diff = %+v; want one modified definition", d)
+	}
+	dd := d.Tables[0].Modified[0]
+	if got := formatChanges(dd.Changes); dd.Hash != 671679327 || got != `displayProperties.name: "Jäger" -> "Hunter"` {
+		t.Fatalf("modified %d: %s", dd.Hash, got)
+	}
+}
+
+func TestDiffJSON(t *testing.T) {
+	for _, tt := range []struct {
+		old, new string
+		want     string
+	}{
+		{`{"a":1}`, `{"a":1.0}`, ``},
+		{`{"a":1}`, `{"a":2}`, `a: 1 -> 2`},
+		{`{"a":1}`, `{"b":1}`, `a: 1 -> (none); b: (none) -> 1`},
+		{`{"p":[1]}`, `{"p":[1,2]}`, `p.1: (none) -> 2`},
+		{`{"p":[1,2]}`, `{"p":[1]}`, `p.1: 2 -> (none)`},
+		{`{"p":[{"h":1}]}`, `{"p":[{"h":2}]}`, `p.0.h: 1 -> 2`},
+		{`{"m":{"k":{"x":true}}}`, `{"m":{"k":{"x":false}}}`, `m.k.x: true -> false`},
+		{`{"a":1}`, `{"a":"1"}`, `a: 1 -> "1"`},
+		{`{"a":{}}`, `{"a":[]}`, `a: {} -> []`},
+		{`{"a":null}`, `{"a":{"b":1}}`, `a: null -> {"b":1}`},
+	} {
+		changes, err := diffJSON([]byte(tt.old), []byte(tt.new))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := formatChanges(changes); got != tt.want {
+			t.Errorf("diffJSON(%s, %s) = %s; want %s", tt.old, tt.new, got, tt.want)
+		}
+	}
+}
+
+func formatChanges(changes []FieldChange) string {
+	var parts []string
+	for _, c := range changes {
+		parts = append(parts, fmt.Sprintf("%s: %s -> %s", c.Path, orNone(c.Old), orNone(c.New)))
+	}
+	return strings.Join(parts, "; ")
+}
+
+func orNone(v json.RawMessage) string {
+	if v == nil {
+		return "(none)"
+	}
+	return string(v)
+}
@@ -0,0 +1,86 @@
+package defs
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// diskPath returns where the table for the given manifest version and locale is kept in c.Dir.
+func (c *Cache) diskPath(version, locale, table string) string {
+	return filepath.Join(c.Dir, safeName(version), safeName(locale), safeName(table)+".json")
+}
+
+// readDisk decodes the table for the given manifest version and locale from c.Dir.
+func (c *Cache) readDisk(version, locale, table string) (map[uint32]json.RawMessage, bool) {
+	if c.Dir == "" {
+		return nil, false
+	}
+	f, err := os.Open(c.diskPath(version, locale, table))
+	if err != nil {
+		return nil, false
+	}
+	defer f.Close()
+	defs, err := decodeTable(bufio.NewReader(f))
+	return defs, err == nil
+}
+
+// diskTemp creates a temporary file to download the table into, or returns nil if there is no
+// disk cache. Pass it to commitDisk or abortDisk when the download is done.
+func (c *Cache) diskTemp(version, locale, table string) *os.File {
+	if c.Dir == "" {
+		return nil
+	}
+	dir := filepath.Dir(c.diskPath(version, locale, table))
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil
+	}
+	f, err := os.CreateTemp(dir, ".tmp-*")
+	if err != nil {
+		return nil
+	}
+	return f
+}
+
+// commitDisk atomically moves the completed temporary file f into place as the table for the given
+// manifest version and locale. Unless c is pinned, copies of the table from other versions are
+// removed.
+func (c *Cache) commitDisk(f *os.File, version, locale, table string) error {
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	path := c.diskPath(version, locale, table)
+	if err := os.Rename(f.Name(), path); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	if c.pinned {
+		// A pinned cache serves an old manifest, usually next to one for the current manifest.
+		return nil
+	}
+	old, _ := filepath.Glob(filepath.Join(c.Dir, "*", safeName(locale), safeName(table)+".json"))
+	for _, p := range old {
+		if p == path {
+			continue
+		}
+		os.Remove(p)
+		// Clean up the locale and version directories once they are empty.
+		os.Remove(filepath.Dir(p))
+		os.Remove(filepath.Dir(filepath.Dir(p)))
+	}
+	return nil
+}
+
+// abortDisk discards the temporary file f.
+func abortDisk(f *os.File) {
+	f.Close()
+	os.Remove(f.Name())
+}
+
+// safeName makes s usable as a single path element.
+func safeName(s string) string {
+	return strings.NewReplacer("/", "_", "\\", "_", "..", "_").Replace(s)
+}
@@ -0,0 +1,71 @@
+package defs
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	bnet "github.com/d2orbc/bungie-api-go"
+)
+
+func writeDisk(t *testing.T, c *Cache, version, locale, table, content string) {
+	t.Helper()
+	f := c.diskTemp(version, locale, table)
+	if f == nil {
+		t.Fatal("no temp file")
+	}
+	fmt.Fprint(f, content)
+	if err := c.commitDisk(f, version, locale, table); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDiskCache(t *testing.T) {
+	c := NewCache(nil)
+	c.Dir = t.TempDir()
+
+	writeDisk(t, c, "v1", "en", "DestinyClassDefinition", `{"1":{"hash":1}}`)
+	writeDisk(t, c, "v1", "de", "DestinyClassDefinition", `{"1":{"hash":1}}`)
+	defs, ok := c.readDisk("v1", "en", "DestinyClassDefinition")
+	if !ok || string(defs[1]) != `{"hash":1}` {
+		t.Fatalf("readDisk(v1) = %v, %v", defs, ok)
+	}
+	if _, ok := c.readDisk("v2", "en", "DestinyClassDefinition"); ok {
+		t.Fatal("read table for a version that was never written")
+	}
+
+	writeDisk(t, c, "v2", "en", "DestinyClassDefinition", `{"2":{"hash":2}}`)
+	if _, ok := c.readDisk("v1", "en", "DestinyClassDefinition"); ok {
+		t.Fatal("stale version not removed")
+	}
+	if _, ok := c.readDisk("v1", "de", "DestinyClassDefinition"); !ok {
+		t.Fatal("other locale removed")
+	}
+	if defs, ok := c.readDisk("v2", "en", "DestinyClassDefinition"); !ok || len(defs) != 1 {
+		t.Fatalf("readDisk(v2) = %v, %v", defs, ok)
+	}
+
+	entries, err := os.ReadDir(c.Dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range entries {
+		if e.Name() != "v1" && e.Name() != "v2" {
+			t.Fatalf("unexpected file %s", e.Name())
+		}
+	}
+}
+
+func TestDiskCachePinned(t *testing.T) {
+	dir := t.TempDir()
+	current := NewCache(nil)
+	current.Dir = dir
+	writeDisk(t, current, "v2", "en", "DestinyClassDefinition", `{}`)
+
+	pinned := NewPinnedCache(bnet.Manifest{Version: "v1"}, "en")
+	pinned.Dir = dir
+	writeDisk(t, pinned, "v1", "en", "DestinyClassDefinition", `{}`)
+	if _, ok := current.readDisk("v2", "en", "DestinyClassDefinition"); !ok {
+		t.Fatal("pinned cache removed another version")
+	}
+}
@@ -0,0 +1,85 @@
+package defs
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	bnet "github.com/d2orbc/bungie-api-go"
+)
+
+// DefaultContentURL is where manifest content is downloaded from by default.
+const DefaultContentURL = "https://www.bungie.net"
+
+// ProgressFunc is called as a download proceeds with the number of bytes received so far, and the
+// total size, or -1 if it is unknown. Sizes are as sent on the wire, which may be compressed.
+type ProgressFunc func(path string, read, total int64)
+
+// Download fetches the manifest content at path from contentURL, or DefaultContentURL if it is
+// empty, using h or else the HTTP client of api. The content is requested gzipped and returned
+// decompressed. The caller must close the body.
+func Download(ctx context.Context, h *http.Client, api *bnet.API, contentURL, path string, progress ProgressFunc) (io.ReadCloser, error) {
+	if contentURL == "" {
+		contentURL = DefaultContentURL
+	}
+	url := strings.TrimSuffix(contentURL, "/") + "/" + strings.TrimPrefix(path, "/")
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	// Setting this explicitly disables transparent decompression in net/http, so it's handled below.
+	// That way compression is used even if the transport has DisableCompression set.
+	req.Header.Set("Accept-Encoding", "gzip")
+	if h == nil && api != nil {
+		h = api.HTTPClient()
+	}
+	if h == nil {
+		h = http.DefaultClient
+	}
+	resp, err := h.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode > 299 {
+		resp.Body.Close()
+		return nil, errors.New(resp.Status)
+	}
+	var body io.Reader = resp.Body
+	if progress != nil {
+		body = &progressReader{r: body, path: path, total: resp.ContentLength, f: progress}
+	}
+	if strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		gz, err := gzip.NewReader(body)
+		if err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+		body = gz
+	}
+	return readCloser{body, resp.Body}, nil
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+type progressReader struct {
+	r     io.Reader
+	path  string
+	read  int64
+	total int64
+	f     ProgressFunc
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	p.read += int64(n)
+	if n > 0 {
+		p.f(p.path, p.read, p.total)
+	}
+	return n, err
+}
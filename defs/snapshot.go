@@ -0,0 +1,162 @@
+package defs
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	bnet "github.com/d2orbc/bungie-api-go"
+)
+
+// snapshotMeta is stored as snapshot.json at the start of a snapshot archive.
+type snapshotMeta struct {
+	Locale   string        `json:"locale"`
+	Manifest bnet.Manifest `json:"manifest"`
+}
+
+// Export writes the manifest and every table loaded for it, in every locale, to w as a gzipped tar
+// archive that LoadSnapshot can read. Load the tables to include first, for example with GetDef or
+// Watch.
+func (c *Cache) Export(w io.Writer) error {
+	c.mu.Lock()
+	meta := snapshotMeta{Locale: c.locale, Manifest: c.manifest}
+	tables := make(map[string]map[uint32]json.RawMessage)
+	for key, t := range c.m {
+		t.mu.Lock()
+		if t.version == meta.Manifest.Version {
+			tables[key.locale+"/"+key.table] = t.defs
+		}
+		t.mu.Unlock()
+	}
+	c.mu.Unlock()
+	if meta.Manifest.Version == "" {
+		return fmt.Errorf("no manifest loaded")
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	now := time.Now()
+	metaJSON, err := json.Marshal(meta)
+	if err != nil {
+		return err
+	}
+	if err := tw.WriteHeader(&tar.Header{Name: "snapshot.json", Mode: 0o644, Size: int64(len(metaJSON)), ModTime: now}); err != nil {
+		return err
+	}
+	if _, err := tw.Write(metaJSON); err != nil {
+		return err
+	}
+	for _, name := range sortedKeys(tables) {
+		defs := tables[name]
+		hashes := make([]uint32, 0, len(defs))
+		for h := range defs {
+			hashes = append(hashes, h)
+		}
+		sort.Slice(hashes, func(i, j int) bool { return hashes[i] < hashes[j] })
+		hdr := &tar.Header{Name: "tables/" + name + ".json", Mode: 0o644, Size: tableSize(defs), ModTime: now}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if err := writeTable(tw, hashes, defs); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// tableSize returns the length of the JSON object written by writeTable.
+func tableSize(defs map[uint32]json.RawMessage) int64 {
+	n := int64(2) // {}
+	for h, def := range defs {
+		n += int64(len(strconv.FormatUint(uint64(h), 10))) + 3 + int64(len(def)) // "h":def
+	}
+	if len(defs) > 1 {
+		n += int64(len(defs) - 1) // commas
+	}
+	return n
+}
+
+// writeTable writes defs as a JSON object without building it in memory.
+func writeTable(w io.Writer, hashes []uint32, defs map[uint32]json.RawMessage) error {
+	bw := bufio.NewWriter(w)
+	bw.WriteByte('{')
+	for i, h := range hashes {
+		if i > 0 {
+			bw.WriteByte(',')
+		}
+		fmt.Fprintf(bw, "%q:", strconv.FormatUint(uint64(h), 10))
+		bw.Write(defs[h])
+	}
+	bw.WriteByte('}')
+	return bw.Flush()
+}
+
+// LoadSnapshot reads an archive written by Export and returns a Cache that serves it without any
+// network access. Lookups in tables that are not in the snapshot fail.
+func LoadSnapshot(r io.Reader) (*Cache, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+	tr := tar.NewReader(gz)
+
+	var c *Cache
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if hdr.Name == "snapshot.json" {
+			var meta snapshotMeta
+			if err := json.NewDecoder(tr).Decode(&meta); err != nil {
+				return nil, err
+			}
+			c = NewPinnedCache(meta.Manifest, meta.Locale)
+			c.offline = true
+			continue
+		}
+		if c == nil {
+			return nil, fmt.Errorf("snapshot: %s before snapshot.json", hdr.Name)
+		}
+		name, ok := strings.CutPrefix(hdr.Name, "tables/")
+		if !ok {
+			continue
+		}
+		locale, table, ok := strings.Cut(strings.TrimSuffix(name, ".json"), "/")
+		if !ok {
+			return nil, fmt.Errorf("snapshot: bad table name %s", hdr.Name)
+		}
+		var defs map[uint32]json.RawMessage
+		if err := json.NewDecoder(tr).Decode(&defs); err != nil {
+			return nil, fmt.Errorf("snapshot: %s: %w", hdr.Name, err)
+		}
+		c.m[tableKey{locale, table}] = &cachedTable{version: c.manifest.Version, defs: defs}
+	}
+	if c == nil {
+		return nil, fmt.Errorf("snapshot: missing snapshot.json")
+	}
+	return c, nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
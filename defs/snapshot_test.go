@@ -0,0 +1,71 @@
+package defs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	bnet "github.com/d2orbc/bungie-api-go"
+)
+
+func TestSnapshot(t *testing.T) {
+	srv := newTestServer(t)
+	c := NewCache(bnet.NewAPI("key").WithBaseURL(srv.URL + "/Platform"))
+	c.ContentURL = srv.URL
+	ctx := context.Background()
+	for _, src := range []bnet.DefSourceContext{c, c.Locale("de")} {
+		if _, err := bnet.Hash[bnet.ClassDefinition](671679327).GetCtx(ctx, src); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := c.Export(&buf); err != nil {
+		t.Fatal(err)
+	}
+	srv.Close()
+	snap, err := LoadSnapshot(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := snap.Version(); v != "v1" {
+		t.Fatalf("version = %q; want v1", v)
+	}
+	for locale, want := range map[string]string{"en": "Hunter", "de": "Jäger"} {
+		def, err := bnet.Hash[bnet.ClassDefinition](671679327).Get(snap.Locale(locale))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := def.DisplayProperties.Name; got != want {
+			t.Fatalf("%s name = %q; want %q", locale, got, want)
+		}
+	}
+	if _, err := bnet.Hash[bnet.RaceDefinition](898834093).Get(snap); err == nil || !strings.Contains(err.Error(), "not in the snapshot") {
+		t.Fatalf("err = %v; want table not in the snapshot", err)
+	}
+}
+
+func TestTableSize(t *testing.T) {
+	for _, defs := range []map[uint32]json.RawMessage{
+		{},
+		{1: json.RawMessage(`{}`)},
+		{7: json.RawMessage(`{"hash":7}`), 3655393761: json.RawMessage(`{"hash":3655393761,"name":"x"}`), 42: json.RawMessage(`null`)},
+	} {
+		var hashes []uint32
+		for h := range defs {
+			hashes = append(hashes, h)
+		}
+		var buf bytes.Buffer
+		if err := writeTable(&buf, hashes, defs); err != nil {
+			t.Fatal(err)
+		}
+		if got := tableSize(defs); got != int64(buf.Len()) {
+			t.Errorf("tableSize = %d; want %d for %s", got, buf.Len(), buf.Bytes())
+		}
+		if !json.Valid(buf.Bytes()) {
+			t.Errorf("invalid table %s", buf.Bytes())
+		}
+	}
+}
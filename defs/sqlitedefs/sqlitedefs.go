@@ -0,0 +1,245 @@
+// Package sqlitedefs serves definitions from the mobile world content SQLite database. It is kept
+// apart from package defs so that only its users link the SQLite driver.
+package sqlitedefs
+
+import (
+	"archive/zip"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	bnet "github.com/d2orbc/bungie-api-go"
+	"github.com/d2orbc/bungie-api-go/defs"
+	_ "modernc.org/sqlite"
+)
+
+// New returns a bnet.DefSource backed by the mobile world content database for locale.
+func New(api *bnet.API, locale string) *Source {
+	if locale == "" {
+		locale = "en"
+	}
+	return &Source{api: api, locale: locale}
+}
+
+// Source serves definitions from the zipped SQLite database referenced by
+// Manifest.MobileWorldContentPaths. The database is downloaded once and queried on demand,
+// so definitions are not held in memory.
+type Source struct {
+	api    *bnet.API
+	locale string
+
+	// Dir is where the database is kept. The default is a directory under os.TempDir.
+	// It must be set before the first lookup.
+	Dir string
+
+	// HTTPClient is used to download the database. The default is the HTTP client of the API.
+	HTTPClient *http.Client
+
+	// ContentURL is prefixed to the content paths in the manifest. The default is defs.DefaultContentURL.
+	ContentURL string
+
+	// Progress, if set, is called as the database is downloaded.
+	Progress defs.ProgressFunc
+
+	mu      sync.RWMutex
+	path    string
+	version string
+	db      *sql.DB
+}
+
+var _ bnet.DefSourceContext = (*Source)(nil)
+
+var tableName = regexp.MustCompile(`^[A-Za-z0-9]+$`)
+
+func (s *Source) GetDef(table string, hash uint32, out any) error {
+	return s.GetDefCtx(context.Background(), table, hash, out)
+}
+
+func (s *Source) GetDefCtx(ctx context.Context, table string, hash uint32, out any) error {
+	if !tableName.MatchString(table) {
+		return fmt.Errorf("unknown definition table %q", table)
+	}
+	if err := s.ensureDB(ctx); err != nil {
+		return err
+	}
+	// Hold the read lock during the query, so that CheckUpdates doesn't close the database under it.
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.db == nil {
+		return errors.New("sqlitedefs: source is closed")
+	}
+	// The id column holds the hash as a signed 32 bit integer.
+	var def []byte
+	err := s.db.QueryRowContext(ctx, "SELECT json FROM "+table+" WHERE id = ?", int32(hash)).Scan(&def)
+	if errors.Is(err, sql.ErrNoRows) {
+		return bnet.ErrDefNotFound
+	}
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(def, out)
+}
+
+// CheckUpdates fetches the manifest and switches to a new database if one was published. Lookups
+// keep using the old database while the new one is downloaded, and the old one is then removed.
+func (s *Source) CheckUpdates(ctx context.Context) error {
+	manifest, err := s.api.Destiny2GetDestinyManifest(ctx, bnet.Destiny2GetDestinyManifestRequest{})
+	if err != nil {
+		return err
+	}
+	locale := s.locale
+	contentPath, ok := manifest.Response.MobileWorldContentPaths[locale]
+	if !ok {
+		// Fallback to "en" if requested locale is not available
+		locale = "en"
+		contentPath, ok = manifest.Response.MobileWorldContentPaths[locale]
+	}
+	if !ok {
+		return errors.New("missing mobile world content path")
+	}
+	s.mu.RLock()
+	current := s.path
+	s.mu.RUnlock()
+	if contentPath == current {
+		return nil
+	}
+	db, dbPath, err := s.open(ctx, locale, contentPath)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if contentPath == s.path {
+		// A concurrent call switched first.
+		return db.Close()
+	}
+	if s.db != nil {
+		s.db.Close()
+	}
+	s.db = db
+	s.path = contentPath
+	s.version = manifest.Response.Version
+	removeStale(dbPath)
+	return nil
+}
+
+// Version returns the manifest version of the open database, or "" if none is open.
+func (s *Source) Version() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.version
+}
+
+// Close closes the database.
+func (s *Source) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.db == nil {
+		return nil
+	}
+	err := s.db.Close()
+	s.db = nil
+	s.path = ""
+	s.version = ""
+	return err
+}
+
+func (s *Source) ensureDB(ctx context.Context) error {
+	s.mu.RLock()
+	open := s.db != nil
+	s.mu.RUnlock()
+	if open {
+		return nil
+	}
+	return s.CheckUpdates(ctx)
+}
+
+// open downloads the database at contentPath unless it is already in s.Dir, and opens it.
+// Databases are kept in a directory per locale.
+func (s *Source) open(ctx context.Context, locale, contentPath string) (*sql.DB, string, error) {
+	dir := s.Dir
+	if dir == "" {
+		dir = filepath.Join(os.TempDir(), "bungie-api-go")
+	}
+	dir = filepath.Join(dir, strings.NewReplacer("/", "_", "\\", "_", "..", "_").Replace(locale))
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, "", err
+	}
+	// The file name contains a content hash, so an existing file is always current.
+	dbPath := filepath.Join(dir, path.Base(contentPath)+".sqlite3")
+	if _, err := os.Stat(dbPath); err != nil {
+		if err := s.downloadDB(ctx, contentPath, dbPath); err != nil {
+			return nil, "", err
+		}
+	}
+	db, err := sql.Open("sqlite", "file:"+dbPath+"?mode=ro")
+	return db, dbPath, err
+}
+
+// removeStale removes the databases of other versions next to dbPath. Errors are ignored, since a
+// database may still be open in another process.
+func removeStale(dbPath string) {
+	old, _ := filepath.Glob(filepath.Join(filepath.Dir(dbPath), "*.sqlite3"))
+	for _, p := range old {
+		if p != dbPath {
+			os.Remove(p)
+		}
+	}
+}
+
+// downloadDB downloads the zipped database at contentPath and extracts it to dbPath.
+func (s *Source) downloadDB(ctx context.Context, contentPath, dbPath string) error {
+	body, err := defs.Download(ctx, s.HTTPClient, s.api, s.ContentURL, contentPath, s.Progress)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	zipFile, err := os.CreateTemp(filepath.Dir(dbPath), ".tmp-*.zip")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(zipFile.Name())
+	defer zipFile.Close()
+	size, err := io.Copy(zipFile, body)
+	if err != nil {
+		return err
+	}
+	zr, err := zip.NewReader(zipFile, size)
+	if err != nil {
+		return err
+	}
+	if len(zr.File) != 1 {
+		return fmt.Errorf("expected 1 file in mobile world content archive, got %d", len(zr.File))
+	}
+	content, err := zr.File[0].Open()
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+
+	dbFile, err := os.CreateTemp(filepath.Dir(dbPath), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(dbFile.Name())
+	if _, err := io.Copy(dbFile, content); err != nil {
+		dbFile.Close()
+		return err
+	}
+	if err := dbFile.Close(); err != nil {
+		return err
+	}
+	return os.Rename(dbFile.Name(), dbPath)
+}
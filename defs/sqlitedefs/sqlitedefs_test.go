@@ -0,0 +1,186 @@
+package sqlitedefs
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	bnet "github.com/d2orbc/bungie-api-go"
+)
+
+// zippedWorldContent returns a zipped SQLite database with a DestinyClassDefinition table holding
+// a definition whose hash doesn't fit in a signed 32 bit integer.
+func zippedWorldContent(t *testing.T) []byte {
+	t.Helper()
+	dbPath := filepath.Join(t.TempDir(), "world.content")
+	db, err := sql.Open("sqlite", dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, stmt := range []string{
+		`CREATE TABLE DestinyClassDefinition (id INTEGER PRIMARY KEY NOT NULL, json BLOB)`,
+		`INSERT INTO DestinyClassDefinition VALUES (671679327, '{"hash":671679327,"index":1}')`,
+		`INSERT INTO DestinyClassDefinition VALUES (-639573535, '{"hash":3655393761,"index":0}')`,
+	} {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+	content, err := os.ReadFile(dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	w, err := zw.Create("world_sql_content_abc.content")
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.Write(content)
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestSource(t *testing.T) {
+	archive := zippedWorldContent(t)
+	var downloads int
+	mux := http.NewServeMux()
+	mux.HandleFunc("/Platform/Destiny2/Manifest/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"ErrorCode":1,"Response":{"version":"v1",
+			"mobileWorldContentPaths":{"en":"/common/destiny2_content/sqlite/en/world_sql_content_abc.content"}}}`)
+	})
+	mux.HandleFunc("/common/destiny2_content/sqlite/en/world_sql_content_abc.content", func(w http.ResponseWriter, r *http.Request) {
+		downloads++
+		w.Write(archive)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	api := bnet.NewAPI("key").WithBaseURL(srv.URL + "/Platform")
+	dir := t.TempDir()
+	newSource := func() *Source {
+		s := New(api, "de")
+		s.Dir = dir
+		s.ContentURL = srv.URL
+		return s
+	}
+
+	s := newSource()
+	if err := s.GetDef("DestinyClassDefinition; DROP TABLE x", 1, &struct{}{}); err == nil || downloads != 0 {
+		t.Fatalf("err = %v after %d downloads; want bad table name rejected", err, downloads)
+	}
+	titan, err := bnet.Hash[bnet.ClassDefinition](3655393761).Get(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if titan.Hash != 3655393761 {
+		t.Fatalf("def = %+v", titan)
+	}
+	if v := s.Version(); v != "v1" {
+		t.Fatalf("version = %q; want v1", v)
+	}
+	if _, err := bnet.Hash[bnet.ClassDefinition](671679327).Get(s); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := bnet.Hash[bnet.ClassDefinition](1).Get(s); err != bnet.ErrDefNotFound {
+		t.Fatalf("err = %v; want ErrDefNotFound", err)
+	}
+	if err := s.GetDef("DestinyRaceDefinition", 1, &struct{}{}); err == nil {
+		t.Fatal("want error for missing table")
+	}
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// A new source reuses the database that was already downloaded.
+	s = newSource()
+	defer s.Close()
+	if _, err := bnet.Hash[bnet.ClassDefinition](671679327).Get(s); err != nil {
+		t.Fatal(err)
+	}
+	if downloads != 1 {
+		t.Fatalf("downloads = %d; want 1", downloads)
+	}
+}
+
+func TestSourceUpdate(t *testing.T) {
+	archive := zippedWorldContent(t)
+	var version atomic.Int32
+	version.Store(1)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/Platform/Destiny2/Manifest/", func(w http.ResponseWriter, r *http.Request) {
+		v := version.Load()
+		fmt.Fprintf(w, `{"ErrorCode":1,"Response":{"version":"v%d",
+			"mobileWorldContentPaths":{"en":"/sqlite/en/world_sql_content_v%d.content"}}}`, v, v)
+	})
+	mux.HandleFunc("/sqlite/en/", func(w http.ResponseWriter, r *http.Request) {
+		w.Write(archive)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	s := New(bnet.NewAPI("key").WithBaseURL(srv.URL+"/Platform"), "en")
+	s.Dir = t.TempDir()
+	s.ContentURL = srv.URL
+	defer s.Close()
+	if _, err := bnet.Hash[bnet.ClassDefinition](671679327).Get(s); err != nil {
+		t.Fatal(err)
+	}
+
+	// Lookups during updates keep working while old databases are closed.
+	stop := make(chan struct{})
+	errs := make(chan error, 4)
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				if _, err := bnet.Hash[bnet.ClassDefinition](671679327).Get(s); err != nil {
+					errs <- err
+					return
+				}
+			}
+		}()
+	}
+	for v := 2; v <= 4; v++ {
+		version.Store(int32(v))
+		if err := s.CheckUpdates(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	close(stop)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatal(err)
+	}
+
+	if v := s.Version(); v != "v4" {
+		t.Fatalf("version = %q; want v4", v)
+	}
+	files, err := filepath.Glob(filepath.Join(s.Dir, "en", "*.sqlite3"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 || filepath.Base(files[0]) != "world_sql_content_v4.content.sqlite3" {
+		t.Fatalf("files = %q; want only the current database", files)
+	}
+}
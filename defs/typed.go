@@ -0,0 +1,124 @@
+package defs
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"sync/atomic"
+
+	bnet "github.com/d2orbc/bungie-api-go"
+)
+
+type definition interface {
+	DefinitionTable() string
+}
+
+// NewTyped returns a Typed cache of up to size decoded definitions from src.
+func NewTyped[T definition](src bnet.DefSource, size int) *Typed[T] {
+	if size <= 0 {
+		size = 1
+	}
+	return &Typed[T]{src: src, size: size, m: make(map[uint32]*list.Element), lru: list.New()}
+}
+
+// Typed memoizes decoded definitions of type T, so that hot definitions are not unmarshalled on
+// every lookup. The least recently used definitions are evicted once size is reached. If the source
+// has a Version method, like Cache, LocaleView and sqlitedefs.Source, all entries are dropped when
+// the version changes.
+//
+// Get returns pointers shared between callers, which must not be modified.
+type Typed[T definition] struct {
+	src  bnet.DefSource
+	size int
+
+	mu      sync.Mutex
+	version string
+	m       map[uint32]*list.Element
+	lru     *list.List
+
+	hits   atomic.Uint64
+	misses atomic.Uint64
+}
+
+type typedEntry[T any] struct {
+	hash uint32
+	def  *T
+}
+
+// TypedStats reports the effectiveness of a Typed cache.
+type TypedStats struct {
+	Hits   uint64
+	Misses uint64
+	Len    int
+}
+
+// HitRate returns the fraction of lookups served from the cache.
+func (s TypedStats) HitRate() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+// Get returns the shared, read-only definition for hash.
+func (c *Typed[T]) Get(ctx context.Context, hash bnet.Hash[T]) (*T, error) {
+	version := c.sourceVersion()
+	c.mu.Lock()
+	if version != c.version {
+		c.m = make(map[uint32]*list.Element)
+		c.lru.Init()
+		c.version = version
+	}
+	if e, ok := c.m[uint32(hash)]; ok {
+		c.lru.MoveToFront(e)
+		c.mu.Unlock()
+		c.hits.Add(1)
+		return e.Value.(*typedEntry[T]).def, nil
+	}
+	c.mu.Unlock()
+	c.misses.Add(1)
+
+	def, err := hash.GetCtx(ctx, c.src)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if version == "" && c.version == "" {
+		// The lookup loaded the first manifest of the source, so the entries are for its version.
+		version = c.sourceVersion()
+		c.version = version
+	}
+	if version != c.version {
+		// The manifest changed while decoding; don't cache a stale definition.
+		return def, nil
+	}
+	if e, ok := c.m[uint32(hash)]; ok {
+		// Another caller decoded it first; share theirs.
+		c.lru.MoveToFront(e)
+		return e.Value.(*typedEntry[T]).def, nil
+	}
+	c.m[uint32(hash)] = c.lru.PushFront(&typedEntry[T]{hash: uint32(hash), def: def})
+	for c.lru.Len() > c.size {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.m, oldest.Value.(*typedEntry[T]).hash)
+	}
+	return def, nil
+}
+
+// Stats returns the hit and miss counts since the cache was created.
+func (c *Typed[T]) Stats() TypedStats {
+	c.mu.Lock()
+	n := c.lru.Len()
+	c.mu.Unlock()
+	return TypedStats{Hits: c.hits.Load(), Misses: c.misses.Load(), Len: n}
+}
+
+func (c *Typed[T]) sourceVersion() string {
+	if v, ok := c.src.(interface{ Version() string }); ok {
+		return v.Version()
+	}
+	return ""
+}
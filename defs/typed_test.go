@@ -0,0 +1,65 @@
+package defs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	bnet "github.com/d2orbc/bungie-api-go"
+)
+
+func TestTyped(t *testing.T) {
+	var version atomic.Int32
+	version.Store(1)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/Platform/Destiny2/Manifest/", func(w http.ResponseWriter, r *http.Request) {
+		v := version.Load()
+		fmt.Fprintf(w, `{"ErrorCode":1,"Response":{"version":"v%d","jsonWorldComponentContentPaths":{
+			"de":{"DestinyClassDefinition":"/content/de/DestinyClassDefinition-v%d.json"}}}}`, v, v)
+	})
+	for _, v := range []int{1, 2} {
+		v := v
+		mux.HandleFunc(fmt.Sprintf("/content/de/DestinyClassDefinition-v%d.json", v), func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{"671679327":{"hash":671679327,"displayProperties":{"name":"Jäger %d"}},"3655393761":{"hash":3655393761}}`, v)
+		})
+	}
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	c := NewCache(bnet.NewAPI("key").WithBaseURL(srv.URL + "/Platform"))
+	c.ContentURL = srv.URL
+	ctx := context.Background()
+
+	typed := NewTyped[bnet.ClassDefinition](c.Locale("de"), 1)
+	hunter, err := typed.Get(ctx, 671679327)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again, _ := typed.Get(ctx, 671679327); again != hunter {
+		t.Fatal("definition not served from the cache")
+	}
+	if _, err := typed.Get(ctx, 3655393761); err != nil {
+		t.Fatal(err)
+	}
+	// The size is 1, so the hunter was evicted.
+	if again, _ := typed.Get(ctx, 671679327); again == hunter {
+		t.Fatal("definition not evicted")
+	}
+	if s := typed.Stats(); s.Hits != 1 || s.Misses != 3 || s.Len != 1 || s.HitRate() != 0.25 {
+		t.Fatalf("stats = %+v", s)
+	}
+
+	version.Store(2)
+	if err := c.CheckUpdates(ctx); err != nil {
+		t.Fatal(err)
+	}
+	hunter, err = typed.Get(ctx, 671679327)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := hunter.DisplayProperties.Name; got != "Jäger 2" {
+		t.Fatalf("name = %q after a manifest update; want Jäger 2", got)
+	}
+}
@@ -0,0 +1,112 @@
+package defs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	bnet "github.com/d2orbc/bungie-api-go"
+)
+
+// ManifestChange is sent by Watch when a new manifest version becomes current.
+type ManifestChange struct {
+	OldVersion string
+	Manifest   bnet.Manifest
+}
+
+// DefaultWatchInterval is the polling interval used by Watch if none is given.
+const DefaultWatchInterval = 5 * time.Minute
+
+// Watch polls for a new manifest every interval, or DefaultWatchInterval if interval is not
+// positive, until ctx is done.
+//
+// When a new version is published, the preload tables are loaded for it first, and then the new
+// manifest and tables become current together, so lookups never see a half-updated cache. Other
+// tables are reloaded on their next lookup. Failed polls are retried at the next interval.
+//
+// Changes are sent on the returned channel, which is closed when ctx is done. The channel doesn't
+// need to be read: a change that hasn't been received is replaced by the next one, which keeps its
+// OldVersion, so a reader always gets the newest version and the one it last saw.
+func (c *Cache) Watch(ctx context.Context, interval time.Duration, preload ...string) <-chan ManifestChange {
+	if interval <= 0 {
+		interval = DefaultWatchInterval
+	}
+	ch := make(chan ManifestChange, 1)
+	go func() {
+		defer close(ch)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		// Compare against the last announced version rather than the current one, so that changes
+		// picked up by CheckUpdates after a lookup miss are announced too.
+		last := c.Version()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			change, ok, err := c.update(ctx, last, preload)
+			if err != nil || !ok {
+				continue
+			}
+			last = change.Manifest.Version
+			select {
+			case ch <- change:
+			case pending := <-ch:
+				change.OldVersion = pending.OldVersion
+				ch <- change
+			}
+		}
+	}()
+	return ch
+}
+
+// update fetches the manifest and, if its version differs from last, preloads tables and makes it current.
+func (c *Cache) update(ctx context.Context, last string, preload []string) (ManifestChange, bool, error) {
+	if c.pinned {
+		return ManifestChange{}, false, nil
+	}
+	manifest, err := c.api.Destiny2GetDestinyManifest(ctx, bnet.Destiny2GetDestinyManifestRequest{})
+	if err != nil {
+		return ManifestChange{}, false, err
+	}
+	mani := manifest.Response
+	if mani.Version == "" {
+		return ManifestChange{}, false, errors.New("missing manifest")
+	}
+	if mani.Version == last {
+		return ManifestChange{}, false, nil
+	}
+
+	type preloaded struct {
+		defs     map[uint32]json.RawMessage
+		fallback bool
+	}
+	loaded := make(map[string]preloaded, len(preload))
+	for _, table := range preload {
+		defs, fallback, err := c.loadTable(ctx, mani, c.locale, table)
+		if err != nil {
+			return ManifestChange{}, false, err
+		}
+		loaded[table] = preloaded{defs, fallback}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	change := ManifestChange{OldVersion: last, Manifest: mani}
+	c.manifest = mani
+	for table, p := range loaded {
+		key := tableKey{c.locale, table}
+		if c.m[key] == nil {
+			c.m[key] = &cachedTable{}
+		}
+		t := c.m[key]
+		t.mu.Lock()
+		t.defs = p.defs
+		t.version = mani.Version
+		t.fallback = p.fallback
+		t.mu.Unlock()
+	}
+	return change, true, nil
+}
@@ -0,0 +1,118 @@
+package defs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	bnet "github.com/d2orbc/bungie-api-go"
+)
+
+func TestWatch(t *testing.T) {
+	var version, downloads atomic.Int32
+	version.Store(1)
+	release := make(chan struct{})
+	mux := http.NewServeMux()
+	mux.HandleFunc("/Platform/Destiny2/Manifest/", func(w http.ResponseWriter, r *http.Request) {
+		v := version.Load()
+		fmt.Fprintf(w, `{"ErrorCode":1,"Response":{"version":"v%d","jsonWorldComponentContentPaths":{
+			"en":{"DestinyClassDefinition":"/content/DestinyClassDefinition-v%d.json"}}}}`, v, v)
+	})
+	for _, v := range []int{1, 2} {
+		v := v
+		mux.HandleFunc(fmt.Sprintf("/content/DestinyClassDefinition-v%d.json", v), func(w http.ResponseWriter, r *http.Request) {
+			if v == 2 {
+				<-release
+			}
+			downloads.Add(1)
+			fmt.Fprintf(w, `{"671679327":{"hash":671679327,"displayProperties":{"name":"Hunter %d"}}}`, v)
+		})
+	}
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	c := NewCache(bnet.NewAPI("key").WithBaseURL(srv.URL + "/Platform"))
+	c.ContentURL = srv.URL
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	name := func() string {
+		t.Helper()
+		def, err := bnet.Hash[bnet.ClassDefinition](671679327).GetCtx(ctx, c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return def.DisplayProperties.Name
+	}
+	if got := name(); got != "Hunter 1" {
+		t.Fatalf("name = %q", got)
+	}
+
+	changes := c.Watch(ctx, 10*time.Millisecond, "DestinyClassDefinition")
+	version.Store(2)
+	// The new table is being preloaded, so lookups keep using the old manifest.
+	time.Sleep(50 * time.Millisecond)
+	if got := name(); got != "Hunter 1" || c.Version() != "v1" {
+		t.Fatalf("name = %q, version = %q during preload; want the old ones", got, c.Version())
+	}
+	close(release)
+
+	select {
+	case change := <-changes:
+		if change.OldVersion != "v1" || change.Manifest.Version != "v2" {
+			t.Fatalf("change = %s -> %s", change.OldVersion, change.Manifest.Version)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no change sent")
+	}
+	if got := name(); got != "Hunter 2" || downloads.Load() != 2 {
+		t.Fatalf("name = %q after %d downloads; want the preloaded table", got, downloads.Load())
+	}
+
+	cancel()
+	for range changes {
+	}
+}
+
+func TestWatchUnread(t *testing.T) {
+	var version atomic.Int32
+	version.Store(1)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/Platform/Destiny2/Manifest/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"ErrorCode":1,"Response":{"version":"v%d"}}`, version.Load())
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	c := NewCache(bnet.NewAPI("key").WithBaseURL(srv.URL + "/Platform"))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if _, err := c.Manifest(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	changes := c.Watch(ctx, time.Millisecond)
+	// Nobody reads the changes, which must not stop the updates.
+	for v := 2; v <= 4; v++ {
+		version.Store(int32(v))
+		want := fmt.Sprintf("v%d", v)
+		for deadline := time.Now().Add(5 * time.Second); c.Version() != want; time.Sleep(time.Millisecond) {
+			if time.Now().After(deadline) {
+				t.Fatalf("version = %q; want %s", c.Version(), want)
+			}
+		}
+	}
+	// Unread changes were merged, so the received ones still chain from v1 to v4.
+	seen := "v1"
+	for change := range changes {
+		if change.OldVersion != seen {
+			t.Fatalf("change = %s -> %s; want it to start at %s", change.OldVersion, change.Manifest.Version, seen)
+		}
+		seen = change.Manifest.Version
+		if seen == "v4" {
+			break
+		}
+	}
+}
@@ -8,15 +8,14 @@ import (
 	"log"
 	"os"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/mitchellh/go-wordwrap"
 )
 
-// TODO: use x-destiny-component-type-dependency
 // TODO: use x-documentation-attributes
-// TODO: automatic ThrottleSecondsBetweenActionPerUser ?
 
 var (
 	specFile = flag.String("spec", "../api-src/openapi.json", "path to openapi spec (v3)")
@@ -29,6 +28,8 @@ type Spec openapi3.T
 var paths buf
 var types buf
 var helpers buf
+var throttles buf
+var operationComponents buf
 
 var wantSchema = map[string]bool{}
 var doneSchema = map[string]bool{}
@@ -65,6 +66,7 @@ func main() {
 	if err := json.Unmarshal(specBytes, &spec); err != nil {
 		log.Fatal(err)
 	}
+	readComponentTypeDependencies(specBytes)
 
 	// Validate assumptions:
 	// Check duplicate schemas
@@ -112,10 +114,27 @@ func main() {
 				}
 			}
 		}
+		if throttle, ok := throttleSeconds(operation); ok {
+			paths.Comment("")
+			paths.Comment("Throttle: %ss between actions per user", throttle)
+			throttles.Out("%q: %s,", operation.OperationID, durationExpr(throttle))
+		}
 		responseIdent := responseType(operation.Responses.Status(200).Ref)
+		if hasParameter(operation, "components") {
+			operationComponents.Out("%q: {", operation.OperationID)
+			for _, c := range reachableComponents(responseSchemaRef(operation.Responses.Status(200).Ref)) {
+				operationComponents.Out("ComponentType_%s,", c)
+			}
+			operationComponents.Out("},")
+		}
 		paths.Out(`func (a API) %s(ctx context.Context, req %sRequest) (*ServerResponse[%s], error) {`, method, method, responseIdent)
 		paths.Debug(operation)
 		paths.Out(`var resp ServerResponse[%s]`, responseIdent)
+		paths.Out("if !a.skipValidation {")
+		paths.Out("if err := req.Validate(); err != nil {")
+		paths.Out("return &resp, err")
+		paths.Out("}")
+		paths.Out("}")
 		paths.Out(`err := a.client.Do(ctx, ClientRequest{Operation:%q,`, operation.OperationID)
 		if path.Get != nil {
 			paths.Out("Method:%q,", "GET")
@@ -133,6 +152,7 @@ func main() {
 		}
 		paths.Out("return &resp, err")
 		paths.Out(`}`)
+		pageIterator(method, operation, responseIdent)
 	}
 
 	// TODO: output hash types
@@ -190,6 +210,8 @@ func main() {
 				}
 				// }
 
+				componentDependencies(ident, ident, componentTypeDependencies[ref])
+
 				for _, fieldName := range orderedKeys(schema.Value.Properties) {
 					prop := schema.Value.Properties[fieldName]
 					types.Out("")
@@ -247,6 +269,20 @@ func main() {
 					helpers.Out("}")
 					helpers.Out(`return fmt.Sprintf("` + ident + `_%d", e)`)
 					helpers.Out("}")
+					helpers.Out("")
+					helpers.Comment("Valid reports whether e is one of the values defined by the API.")
+					helpers.Out("func (e %s) Valid() bool {", ident)
+					helpers.Out("switch e {")
+					for _, val := range values {
+						helpers.Out("case %s_%s:", ident, val.(map[string]interface{})["identifier"].(string))
+						helpers.Out("return true")
+					}
+					helpers.Out("}")
+					helpers.Out("return false")
+					helpers.Out("}")
+					if ident == "ComponentType" {
+						componentSetMethods(values)
+					}
 				}
 			} else if schema.Value.Type.Is("array") {
 				// do nothing
@@ -262,6 +298,8 @@ func main() {
 		}
 	}
 
+	genericComponentDependencies()
+
 	fmt.Println(`
 package bnet
 
@@ -269,11 +307,280 @@ import (
 "context"
 "fmt"
 "net/url"
+"time"
 )
 	`)
 	os.Stdout.ReadFrom(&paths)
 	os.Stdout.ReadFrom(&types)
 	os.Stdout.ReadFrom(&helpers)
+
+	fmt.Println(`
+// OperationThrottles maps ClientRequest.Operation to the minimum time between calls by the same user,
+// as documented by ThrottleSecondsBetweenActionPerUser in the spec.
+var OperationThrottles = map[string]time.Duration{`)
+	os.Stdout.ReadFrom(&throttles)
+	fmt.Println("}")
+
+	fmt.Println(`
+// OperationComponents maps ClientRequest.Operation to the components that the operation can return,
+// for operations that take a components parameter.
+var OperationComponents = map[string][]ComponentType{`)
+	os.Stdout.ReadFrom(&operationComponents)
+	fmt.Println("}")
+}
+
+// throttleSeconds returns the ThrottleSecondsBetweenActionPerUser documentation attribute of op.
+func throttleSeconds(op *openapi3.Operation) (string, bool) {
+	attrs, ok := op.Extensions["x-documentation-attributes"].(map[string]any)
+	if !ok {
+		return "", false
+	}
+	v, ok := attrs["ThrottleSecondsBetweenActionPerUser"].(string)
+	return v, ok
+}
+
+// durationExpr returns a Go expression for the duration of secs seconds.
+func durationExpr(secs string) string {
+	f, err := strconv.ParseFloat(secs, 64)
+	if err != nil {
+		panic(fmt.Errorf("bad throttle %q: %v", secs, err))
+	}
+	ms := int64(f * 1000)
+	if ms%1000 == 0 {
+		return fmt.Sprintf("%d * time.Second", ms/1000)
+	}
+	return fmt.Sprintf("%d * time.Millisecond", ms)
+}
+
+// componentTypeDependencies maps schema refs to the x-destiny-component-type-dependency of each of
+// their properties. It is read from the raw spec because the extension is often a sibling of $ref,
+// which openapi3 drops.
+var componentTypeDependencies = map[string]map[string]string{}
+
+func readComponentTypeDependencies(specBytes []byte) {
+	var raw struct {
+		Components struct {
+			Schemas map[string]struct {
+				Properties map[string]struct {
+					Dependency string `json:"x-destiny-component-type-dependency"`
+				} `json:"properties"`
+			} `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(specBytes, &raw); err != nil {
+		log.Fatal(err)
+	}
+	for ref, schema := range raw.Components.Schemas {
+		for fieldName, prop := range schema.Properties {
+			if prop.Dependency == "" {
+				continue
+			}
+			if componentTypeDependencies[ref] == nil {
+				componentTypeDependencies[ref] = map[string]string{}
+			}
+			componentTypeDependencies[ref][fieldName] = prop.Dependency
+		}
+	}
+}
+
+// componentDependencies emits a ComponentDependencies method on recv, mapping each field to the
+// ComponentType that populates it.
+func componentDependencies(ident, recv string, deps map[string]string) {
+	if len(deps) == 0 {
+		return
+	}
+	helpers.Out("")
+	helpers.Comment("ComponentDependencies returns the ComponentType that must be requested to populate each field of %s.", ident)
+	helpers.Out("func (%s) ComponentDependencies() map[string]ComponentType {", recv)
+	helpers.Out("return map[string]ComponentType{")
+	for _, fieldName := range orderedKeys(deps) {
+		helpers.Out("%q: ComponentType_%s,", capitalize(fieldName), deps[fieldName])
+	}
+	helpers.Out("}")
+	helpers.Out("}")
+	helpers.Out("")
+	helpers.Comment("ComponentErrors returns ErrComponentPrivate or ErrComponentDisabled for each component of %s that was requested but not returned, keyed by field path.", ident)
+	helpers.Out("func (r %s) ComponentErrors() map[string]error {", recv)
+	helpers.Out("return componentErrors(r)")
+	helpers.Out("}")
+}
+
+// genericComponentDependencies emits ComponentDependencies for the hand-written generic component
+// sets in refToTypeOverride, using the fields common to all of the schemas they replace.
+func genericComponentDependencies() {
+	common := map[string]map[string]string{}
+	for _, ref := range orderedKeys(componentTypeDependencies) {
+		override := refToTypeOverride[ref]
+		if !strings.Contains(override, "ComponentSet[") {
+			continue
+		}
+		ident := override[:strings.Index(override, "[")]
+		deps := componentTypeDependencies[ref]
+		c, ok := common[ident]
+		if !ok {
+			c = map[string]string{}
+			for fieldName, dep := range deps {
+				c[fieldName] = dep
+			}
+			common[ident] = c
+			continue
+		}
+		for fieldName := range c {
+			if _, ok := deps[fieldName]; !ok {
+				delete(c, fieldName)
+			}
+		}
+	}
+	for _, ident := range orderedKeys(common) {
+		componentDependencies(ident, ident+"[T]", common[ident])
+	}
+}
+
+// pageIterator emits an XxxAll method that walks every page of the operation, if it is paged.
+func pageIterator(method string, op *openapi3.Operation, responseIdent string) {
+	schema := spec.Components.Schemas[strings.TrimPrefix(responseSchemaRef(op.Responses.Status(200).Ref), "#/components/schemas/")]
+	if schema == nil {
+		return
+	}
+	props := schema.Value.Properties
+	if props["hasMore"] == nil {
+		return
+	}
+	resultsField := "results"
+	if props[resultsField] == nil {
+		resultsField = "searchResults"
+	}
+	if props[resultsField] == nil {
+		return
+	}
+	itemType := strings.TrimPrefix(typeFromSchema(props[resultsField]), "[]")
+
+	var pageField, tokenField string
+	for _, param := range op.Parameters {
+		switch param.Value.Name {
+		case "page", "currentpage", "pageNumber":
+			if param.Value.Schema.Value.Format == "int32" {
+				pageField = "req." + capitalize(param.Value.Name)
+			}
+		}
+	}
+	if op.RequestBody != nil && typeFromSchema(op.RequestBody.Value.Content.Get("application/json").Schema) == "GroupQuery" {
+		pageField = "req.Body.CurrentPage"
+		tokenField = "req.Body.RequestContinuationToken"
+	}
+	if pageField == "" {
+		return
+	}
+
+	paths.Out("")
+	paths.Comment("%sAll returns an iterator over the results of every page of %s, starting at the page in req. See Paginate.", method, method)
+	paths.Out("func (a API) %sAll(ctx context.Context, req %sRequest) func(yield func(%s, error) bool) {", method, method, itemType)
+	paths.Out("return Paginate(ctx, %s, func(ctx context.Context, page int32, token string) (Page[%s], error) {", pageField, itemType)
+	paths.Out("%s = page", pageField)
+	if tokenField != "" {
+		paths.Out("if token != \"\" {")
+		paths.Out("%s = token", tokenField)
+		paths.Out("}")
+	}
+	paths.Out("resp, err := a.%s(ctx, req)", method)
+	paths.Out("if err != nil {")
+	paths.Out("return Page[%s]{}, err", itemType)
+	paths.Out("}")
+	paths.Out("return Page[%s]{", itemType)
+	paths.Out("Results: resp.Response.%s,", capitalize(resultsField))
+	paths.Out("HasMore: resp.Response.HasMore,")
+	if props["replacementContinuationToken"] != nil {
+		paths.Out("ContinuationToken: resp.Response.ReplacementContinuationToken,")
+	}
+	paths.Out("}, nil")
+	paths.Out("})")
+	paths.Out("}")
+}
+
+func hasParameter(op *openapi3.Operation, name string) bool {
+	for _, param := range op.Parameters {
+		if param.Value.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// responseSchemaRef returns the schema of the Response field of the response ref.
+func responseSchemaRef(ref string) string {
+	ref = strings.TrimPrefix(ref, "#/components/responses/")
+	l, err := spec.Components.Responses.JSONLookup(ref)
+	if err != nil {
+		panic(fmt.Errorf("couldnt find ref %s: %v", ref, err))
+	}
+	return l.(*openapi3.Response).Content.Get("application/json").Schema.Value.Properties["Response"].Ref
+}
+
+// reachableComponents returns the component types that populate any field reachable from the schema
+// ref, ordered by value.
+func reachableComponents(ref string) []string {
+	found := map[string]bool{}
+	visited := map[string]bool{}
+	var walk func(s *openapi3.SchemaRef)
+	walk = func(s *openapi3.SchemaRef) {
+		if s == nil {
+			return
+		}
+		if s.Ref != "" {
+			name := strings.TrimPrefix(s.Ref, "#/components/schemas/")
+			if visited[name] {
+				return
+			}
+			visited[name] = true
+			for _, dep := range componentTypeDependencies[name] {
+				found[dep] = true
+			}
+			walk(spec.Components.Schemas[name])
+			return
+		}
+		if s.Value == nil {
+			return
+		}
+		for _, sub := range s.Value.AllOf {
+			walk(sub)
+		}
+		for _, prop := range s.Value.Properties {
+			walk(prop)
+		}
+		walk(s.Value.Items)
+		walk(s.Value.AdditionalProperties.Schema)
+	}
+	walk(&openapi3.SchemaRef{Ref: ref})
+
+	var components []string
+	values := spec.Components.Schemas["Destiny.DestinyComponentType"].Value.Extensions["x-enum-values"].([]any)
+	for _, val := range values {
+		ident := val.(map[string]any)["identifier"].(string)
+		if found[ident] {
+			components = append(components, ident)
+		}
+	}
+	return components
+}
+
+// componentSetMethods emits a ComponentSet builder method for each ComponentType.
+func componentSetMethods(values []any) {
+	for _, val := range values {
+		val := val.(map[string]any)
+		ident := val["identifier"].(string)
+		if ident == "None" {
+			continue
+		}
+		helpers.Out("")
+		helpers.Comment("%s adds ComponentType_%s.", ident, ident)
+		if desc, ok := val["description"].(string); ok {
+			helpers.Comment("")
+			helpers.Comment(desc)
+		}
+		helpers.Out("func (c ComponentSet) %s() ComponentSet {", ident)
+		helpers.Out("return c.With(ComponentType_%s)", ident)
+		helpers.Out("}")
+	}
 }
 
 func handleGenerics(schemas openapi3.Schemas) {
@@ -379,6 +686,7 @@ func (b *buf) Out(s string, params ...interface{}) {
 }
 
 func methodParameters(w *buf, method string, op *openapi3.Operation, paramBuf, queryBuf *buf) {
+	var validate buf
 	w.Comment("%sRequest are the request parameters for operation %s", method, op.OperationID)
 	w.Out(`type %sRequest struct {`, method)
 	for _, param := range op.Parameters {
@@ -395,6 +703,7 @@ func methodParameters(w *buf, method string, op *openapi3.Operation, paramBuf, q
 		fieldType := typeFromSchema(param.Value.Schema)
 		wantSchema[fieldType] = true
 		w.Out(`%s %s`, capitalize(param.Value.Name), fieldType)
+		paramValidation(&validate, method, param.Value, fieldType)
 		val := fmt.Sprintf("fmt.Sprint(req.%s)", capitalize(param.Value.Name))
 		if param.Value.Schema.Value.Type.Is("array") {
 			val = fmt.Sprintf("joinArray(req.%s)", capitalize(param.Value.Name))
@@ -418,6 +727,64 @@ func methodParameters(w *buf, method string, op *openapi3.Operation, paramBuf, q
 	}
 
 	w.Out(`}`)
+	w.Out("")
+	w.Comment("Validate checks that the required parameters of r are set and that enum parameters have values defined by the API.")
+	w.Out("func (r %sRequest) Validate() error {", method)
+	// The checks end in a newline, and there may be none.
+	w.WriteString(validate.String())
+	w.Out("return nil")
+	w.Out("}")
+}
+
+// paramValidation emits the checks of Validate for param, which has the Go type fieldType.
+func paramValidation(w *buf, method string, param *openapi3.Parameter, fieldType string) {
+	field := capitalize(param.Name)
+	invalid := func(format string) string {
+		return fmt.Sprintf("return invalidRequest(%q, %q, %s)", method+"Request", field, format)
+	}
+	schema := param.Schema.Value
+	isArray := schema.Type.Is("array")
+	if isArray {
+		schema = schema.Items.Value
+	}
+	_, isEnum := schema.Extensions["x-enum-reference"]
+	isBitmask, _ := schema.Extensions["x-enum-is-bitmask"].(bool)
+
+	if param.Required {
+		switch {
+		case fieldType == "string":
+			w.Out(`if r.%s == "" {`, field)
+			w.Out(invalid(`"required"`))
+			w.Out("}")
+		case fieldType == "Int64" || fieldType == "uint32":
+			w.Out(`if r.%s == 0 {`, field)
+			w.Out(invalid(`"required"`))
+			w.Out("}")
+		case fieldType == "BungieMembershipType" || fieldType == "BungieCredentialType":
+			// None is defined for these, but is never a meaningful argument.
+			w.Out(`if r.%s == %s_None {`, field, fieldType)
+			w.Out(invalid(`"required"`))
+			w.Out("}")
+		}
+	}
+	if !isEnum || isBitmask {
+		return
+	}
+	if isArray {
+		w.Out("for _, v := range r.%s {", field)
+		w.Out("if !v.Valid() {")
+		w.Out(invalid(fmt.Sprintf(`fmt.Sprintf("undefined %s %%d", v)`, strings.TrimPrefix(fieldType, "[]"))))
+		w.Out("}")
+		w.Out("}")
+		return
+	}
+	if param.Required {
+		w.Out("if !r.%s.Valid() {", field)
+	} else {
+		w.Out("if r.%s != 0 && !r.%s.Valid() {", field, field)
+	}
+	w.Out(invalid(fmt.Sprintf(`fmt.Sprintf("undefined %s %%d", r.%s)`, fieldType, field)))
+	w.Out("}")
 }
 
 func capitalize(s string) string {
@@ -0,0 +1,146 @@
+package bnet
+
+import (
+	"context"
+	"time"
+)
+
+// maxActivityHistoryCount is the largest Count accepted by Destiny2GetActivityHistory.
+const maxActivityHistoryCount = 250
+
+// ActivityHistoryRequest selects the activities walked by API.ActivityHistory.
+type ActivityHistoryRequest struct {
+	MembershipType      BungieMembershipType
+	DestinyMembershipID Int64
+
+	// CharacterIDs limits the walk to these characters. If empty, every character of the account
+	// is walked, including deleted ones, as listed by Destiny2GetHistoricalStatsForAccount.
+	CharacterIDs []Int64
+
+	// Mode filters activities by mode. The default is all modes.
+	Mode ActivityModeType
+
+	// PageSize is the number of activities fetched per request. The default is the maximum, 250.
+	PageSize int32
+
+	// StopAtInstanceID ends the walk before the activity with this instance ID, such as the newest
+	// activity seen by a previous sync.
+	StopAtInstanceID Int64
+
+	// Since ends the walk before activities that started at or before this time.
+	Since time.Time
+}
+
+// CharacterActivity is an activity in the history of a character.
+type CharacterActivity struct {
+	CharacterID Int64
+	HistoricalStatsPeriodGroup
+}
+
+// ActivityHistory returns an iterator over the activities of all characters of a profile, newest
+// first. Each character's history is paged in as the loop reaches it, and the walk stops at the
+// first error, which is yielded with a zero CharacterActivity.
+//
+//	for act, err := range api.ActivityHistory(ctx, bnet.ActivityHistoryRequest{
+//		MembershipType:      membershipType,
+//		DestinyMembershipID: membershipID,
+//		StopAtInstanceID:    lastSynced,
+//	}) {
+//		...
+//	}
+func (a API) ActivityHistory(ctx context.Context, req ActivityHistoryRequest) func(yield func(CharacterActivity, error) bool) {
+	return func(yield func(CharacterActivity, error) bool) {
+		characterIDs := req.CharacterIDs
+		if len(characterIDs) == 0 {
+			var err error
+			characterIDs, err = a.allCharacterIDs(ctx, req.MembershipType, req.DestinyMembershipID)
+			if err != nil {
+				yield(CharacterActivity{}, err)
+				return
+			}
+		}
+		pageSize := req.PageSize
+		if pageSize <= 0 || pageSize > maxActivityHistoryCount {
+			pageSize = maxActivityHistoryCount
+		}
+		cursors := make([]*historyCursor, len(characterIDs))
+		for i, id := range characterIDs {
+			cursors[i] = &historyCursor{req: Destiny2GetActivityHistoryRequest{
+				MembershipType:      req.MembershipType,
+				DestinyMembershipID: req.DestinyMembershipID,
+				CharacterID:         id,
+				Mode:                req.Mode,
+				Count:               pageSize,
+			}}
+		}
+
+		for {
+			// Take the newest of the activities at the head of each character's history.
+			var next *historyCursor
+			for _, c := range cursors {
+				if err := c.fill(ctx, a); err != nil {
+					yield(CharacterActivity{}, err)
+					return
+				}
+				if len(c.buf) > 0 && (next == nil || c.buf[0].Period.Time().After(next.buf[0].Period.Time())) {
+					next = c
+				}
+			}
+			if next == nil {
+				return
+			}
+			act := next.buf[0]
+			next.buf = next.buf[1:]
+			// Every remaining activity is older, so the walk is done.
+			if req.StopAtInstanceID != 0 && act.ActivityDetails.InstanceID == req.StopAtInstanceID {
+				return
+			}
+			if !req.Since.IsZero() && !act.Period.Time().After(req.Since) {
+				return
+			}
+			if !yield(CharacterActivity{CharacterID: next.req.CharacterID, HistoricalStatsPeriodGroup: act}, nil) {
+				return
+			}
+		}
+	}
+}
+
+func (a API) allCharacterIDs(ctx context.Context, membershipType BungieMembershipType, membershipID Int64) ([]Int64, error) {
+	resp, err := a.Destiny2GetHistoricalStatsForAccount(ctx, Destiny2GetHistoricalStatsForAccountRequest{
+		MembershipType:      membershipType,
+		DestinyMembershipID: membershipID,
+		Groups:              []StatsGroupType{StatsGroupType_General},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var ids []Int64
+	for _, c := range resp.Response.Characters {
+		ids = append(ids, c.CharacterID)
+	}
+	return ids, nil
+}
+
+// historyCursor pages through the activity history of one character.
+type historyCursor struct {
+	req  Destiny2GetActivityHistoryRequest
+	buf  []HistoricalStatsPeriodGroup
+	done bool
+}
+
+// fill fetches the next page if the buffer is empty. The end of the history is an empty page.
+func (c *historyCursor) fill(ctx context.Context, a API) error {
+	if len(c.buf) > 0 || c.done {
+		return nil
+	}
+	resp, err := a.Destiny2GetActivityHistory(ctx, c.req)
+	if err != nil {
+		return err
+	}
+	c.buf = resp.Response.Activities
+	c.req.Page++
+	if len(c.buf) < int(c.req.Count) {
+		c.done = true
+	}
+	return nil
+}
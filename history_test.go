@@ -0,0 +1,58 @@
+package bnet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestActivityHistory(t *testing.T) {
+	// Character 1 played at hours 5, 3 and 1, character 2 (deleted) at hours 4 and 2.
+	history := map[string][]int{"1": {5, 3, 1}, "2": {4, 2}}
+	api := (&API{}).WithInterceptorFunc(func(_ Client, ctx context.Context, r ClientRequest, resp any) error {
+		if r.Operation == "Destiny2.GetHistoricalStatsForAccount" {
+			return json.Unmarshal([]byte(`{"ErrorCode":1,"Response":{"characters":[
+				{"characterId":"1"},{"characterId":"2","deleted":true}]}}`), resp)
+		}
+		var page, count int
+		fmt.Sscan(r.QueryParams.Get("page"), &page)
+		fmt.Sscan(r.QueryParams.Get("count"), &count)
+		hours := history[r.PathParams["characterId"]]
+		var acts []string
+		for i := page * count; i < len(hours) && i < (page+1)*count; i++ {
+			period := time.Date(2024, 1, 1, hours[i], 0, 0, 0, time.UTC).Format(time.RFC3339)
+			acts = append(acts, fmt.Sprintf(`{"period":%q,"activityDetails":{"instanceId":"%d"}}`, period, hours[i]))
+		}
+		return json.Unmarshal([]byte(`{"ErrorCode":1,"Response":{"activities":[`+strings.Join(acts, ",")+`]}}`), resp)
+	})
+
+	walk := func(req ActivityHistoryRequest) string {
+		req.MembershipType = BungieMembershipType_TigerSteam
+		req.DestinyMembershipID = 42
+		req.PageSize = 2
+		var got []string
+		api.ActivityHistory(context.Background(), req)(func(act CharacterActivity, err error) bool {
+			if err != nil {
+				t.Fatal(err)
+			}
+			got = append(got, fmt.Sprintf("%d/%d", act.CharacterID, act.ActivityDetails.InstanceID))
+			return true
+		})
+		return strings.Join(got, " ")
+	}
+	if got, want := walk(ActivityHistoryRequest{}), "1/5 2/4 1/3 2/2 1/1"; got != want {
+		t.Errorf("all = %s; want %s", got, want)
+	}
+	if got, want := walk(ActivityHistoryRequest{StopAtInstanceID: 2}), "1/5 2/4 1/3"; got != want {
+		t.Errorf("StopAtInstanceID = %s; want %s", got, want)
+	}
+	if got, want := walk(ActivityHistoryRequest{Since: time.Date(2024, 1, 1, 3, 0, 0, 0, time.UTC)}), "1/5 2/4"; got != want {
+		t.Errorf("Since = %s; want %s", got, want)
+	}
+	if got, want := walk(ActivityHistoryRequest{CharacterIDs: []Int64{2}}), "2/4 2/2"; got != want {
+		t.Errorf("CharacterIDs = %s; want %s", got, want)
+	}
+}
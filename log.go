@@ -0,0 +1,174 @@
+package bnet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// LogOptions configures API.WithLogOptions.
+type LogOptions struct {
+	// Level is the level of successful calls. The default is slog.LevelInfo.
+	Level slog.Leveler
+
+	// ErrorLevel is the level of failed calls. The default is slog.LevelWarn.
+	ErrorLevel slog.Leveler
+
+	// BodyLimit is the number of bytes of request and response bodies to log at slog.LevelDebug,
+	// along with the request headers. Zero means bodies are not logged.
+	BodyLimit int
+}
+
+// WithLogger logs every call to l with the default LogOptions.
+func (a *API) WithLogger(l *slog.Logger) *API {
+	return a.WithLogOptions(l, LogOptions{})
+}
+
+// WithLogOptions logs every call to l: its operation, URL, latency, HTTP status and, for failures,
+// the error and Bungie error details. Credentials are redacted from URLs and headers.
+//
+// The logger sees requests as changed by the interceptors installed after it, so install it before
+// WithAuthToken and WithRetry to log the headers that are sent and every attempt.
+func (a *API) WithLogOptions(l *slog.Logger, o LogOptions) *API {
+	level := o.Level
+	if level == nil {
+		level = slog.LevelInfo
+	}
+	errorLevel := o.ErrorLevel
+	if errorLevel == nil {
+		errorLevel = slog.LevelWarn
+	}
+	return a.WithInterceptorFunc(func(base Client, ctx context.Context, r ClientRequest, resp any) error {
+		start := time.Now()
+		err := base.Do(ctx, r, resp)
+		latency := time.Since(start)
+
+		lvl := level.Level()
+		if err != nil {
+			lvl = errorLevel.Level()
+		}
+		debug := o.BodyLimit > 0 && l.Enabled(ctx, slog.LevelDebug)
+		if !l.Enabled(ctx, lvl) && !debug {
+			return err
+		}
+
+		var meta ResponseMeta
+		if m, ok := resp.(interface{ Meta() ResponseMeta }); ok {
+			meta = m.Meta()
+		}
+		u := meta.URL
+		if u == "" {
+			u = getPath(r.PathSpec, r.PathParams, r.QueryParams)
+		}
+		attrs := []slog.Attr{
+			slog.String("operation", r.Operation),
+			slog.String("method", r.Method),
+			slog.String("url", redactURL(u)),
+			slog.Duration("latency", latency),
+		}
+		if meta.StatusCode != 0 {
+			attrs = append(attrs, slog.Int("status", meta.StatusCode))
+		}
+		var bErr *BungieError
+		var hErr *HTTPError
+		switch {
+		case errors.As(err, &bErr):
+			attrs = append(attrs, slog.String("error_code", bErr.Code.Enum()), slog.String("error_status", bErr.Status))
+			if bErr.ThrottleSeconds > 0 {
+				attrs = append(attrs, slog.Int("throttle_seconds", int(bErr.ThrottleSeconds)))
+			}
+		case errors.As(err, &hErr) && meta.StatusCode == 0:
+			attrs = append(attrs, slog.Int("status", hErr.Code))
+		}
+		if err != nil {
+			attrs = append(attrs, slog.String("error", err.Error()))
+		}
+		if l.Enabled(ctx, lvl) {
+			l.LogAttrs(ctx, lvl, "bnet call", attrs...)
+		}
+
+		if debug {
+			attrs = append(attrs, slog.Any("request_headers", redactHeaders(r.Headers)))
+			if r.Body != nil {
+				body, _ := json.Marshal(r.Body)
+				attrs = append(attrs, slog.String("request_body", truncate(body, o.BodyLimit)))
+			}
+			if raw, ok := resp.(interface{ Raw() []byte }); ok {
+				body := raw.Raw()
+				if body == nil && hErr != nil {
+					body = hErr.Body
+				}
+				attrs = append(attrs, slog.String("response_body", truncate(body, o.BodyLimit)))
+			}
+			l.LogAttrs(ctx, slog.LevelDebug, "bnet call bodies", attrs...)
+		}
+		return err
+	})
+}
+
+const redacted = "REDACTED"
+
+// redactURL replaces credentials in the user info and query of u.
+func redactURL(u string) string {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return u
+	}
+	if parsed.User != nil {
+		parsed.User = url.User(redacted)
+	}
+	q := parsed.Query()
+	changed := false
+	for k := range q {
+		if isSecret(k) {
+			q.Set(k, redacted)
+			changed = true
+		}
+	}
+	if changed {
+		parsed.RawQuery = q.Encode()
+	}
+	return parsed.String()
+}
+
+func redactHeaders(h map[string]string) map[string]string {
+	out := make(map[string]string, len(h))
+	for k, v := range h {
+		switch {
+		case strings.EqualFold(k, "Authorization"):
+			// Keep the scheme, if there is one, and never the credentials.
+			if scheme, _, ok := strings.Cut(v, " "); ok {
+				out[k] = scheme + " " + redacted
+			} else {
+				out[k] = redacted
+			}
+		case isSecret(k):
+			out[k] = redacted
+		default:
+			out[k] = v
+		}
+	}
+	return out
+}
+
+func isSecret(name string) bool {
+	name = strings.ToLower(name)
+	for _, s := range []string{"key", "token", "secret", "password", "code"} {
+		if strings.Contains(name, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func truncate(b []byte, limit int) string {
+	if len(b) <= limit {
+		return string(b)
+	}
+	return fmt.Sprintf("%s... (%d more bytes)", b[:limit], len(b)-limit)
+}
@@ -0,0 +1,68 @@
+package bnet
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLogger(t *testing.T) {
+	var buf bytes.Buffer
+	l := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	api := (&API{}).WithInterceptorFunc(func(_ Client, ctx context.Context, r ClientRequest, resp any) error {
+		return &BungieError{Code: PlatformErrorCodes_ThrottleLimitExceeded, ThrottleSeconds: 3}
+	}).WithLogOptions(l, LogOptions{BodyLimit: 8}).WithAuthToken("secret-token")
+
+	api.Destiny2EquipItem(context.Background(), Destiny2EquipItemRequest{Body: ItemActionRequestBody{ItemID: 1234567890}})
+	out := buf.String()
+	for _, want := range []string{
+		"level=WARN", "operation=Destiny2.EquipItem", "error_code=ThrottleLimitExceeded", "throttle_seconds=3",
+		"Authorization:Bearer REDACTED", `request_body="{\"charac... (52 more bytes)"`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("log does not contain %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "secret-token") {
+		t.Errorf("log contains token:\n%s", out)
+	}
+}
+
+func TestLoggerDisabled(t *testing.T) {
+	l := slog.New(slog.NewTextHandler(&bytes.Buffer{}, &slog.HandlerOptions{Level: slog.LevelError}))
+	want := &BungieError{Code: PlatformErrorCodes_SystemDisabled}
+	api := (&API{}).WithInterceptorFunc(func(_ Client, ctx context.Context, r ClientRequest, resp any) error {
+		return want
+	}).WithLogger(l)
+	if _, err := api.Destiny2GetDestinyManifest(context.Background(), Destiny2GetDestinyManifestRequest{}); err != want {
+		t.Fatalf("err = %v; want %v", err, want)
+	}
+}
+
+func TestRedactURL(t *testing.T) {
+	got := redactURL("https://www.bungie.net/Platform/App/?apikey=abc&page=2&access_token=xyz")
+	if want := "https://www.bungie.net/Platform/App/?access_token=REDACTED&apikey=REDACTED&page=2"; got != want {
+		t.Fatalf("got %s; want %s", got, want)
+	}
+}
+
+func TestRedactHeaders(t *testing.T) {
+	got := redactHeaders(map[string]string{
+		"Authorization": "s3cret",
+		"authorization": "Bearer s3cret",
+		"X-API-Key":     "abc",
+		"Accept":        "application/json",
+	})
+	want := map[string]string{
+		"Authorization": "REDACTED",
+		"authorization": "Bearer REDACTED",
+		"X-API-Key":     "REDACTED",
+		"Accept":        "application/json",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v; want %v", got, want)
+	}
+}
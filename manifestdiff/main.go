@@ -0,0 +1,141 @@
+// Command manifestdiff reports the definitions that changed between two Destiny manifests.
+//
+// Save the current manifest, and compare against it after the next patch:
+//
+//	manifestdiff -save old.json
+//	manifestdiff -old old.json -tables DestinyInventoryItemDefinition,DestinySandboxPerkDefinition
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	bnet "github.com/d2orbc/bungie-api-go"
+	"github.com/d2orbc/bungie-api-go/defs"
+)
+
+var (
+	oldFile  = flag.String("old", "", "path to the old manifest, as saved by -save")
+	newFile  = flag.String("new", "", "path to the new manifest; defaults to the current manifest")
+	saveFile = flag.String("save", "", "save the current manifest to this path and exit")
+	tables   = flag.String("tables", "", "comma separated tables to compare; defaults to all")
+	locale   = flag.String("locale", "en", "manifest locale")
+	jsonOut  = flag.Bool("json", false, "print the report as JSON")
+	cacheDir = flag.String("cache", "", "directory to keep downloaded tables in")
+)
+
+func main() {
+	flag.Parse()
+	ctx := context.Background()
+	api := bnet.NewAPI(os.Getenv("BUNGIE_API_KEY"))
+
+	current := defs.NewCacheWithLocale(api, *locale)
+	if *saveFile != "" {
+		mani, err := current.Manifest(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+		b, err := json.Marshal(mani)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := os.WriteFile(*saveFile, b, 0o644); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if *oldFile == "" {
+		log.Fatal("-old is required")
+	}
+	oldCache := defs.NewPinnedCache(readManifest(*oldFile), *locale)
+	newCache := current
+	if *newFile != "" {
+		newCache = defs.NewPinnedCache(readManifest(*newFile), *locale)
+	}
+	if *cacheDir != "" {
+		// The cache of the current manifest removes tables of other versions, so the pinned
+		// caches keep theirs in a separate directory.
+		oldCache.Dir = filepath.Join(*cacheDir, "pinned")
+		newCache.Dir = filepath.Join(*cacheDir, "current")
+		if *newFile != "" {
+			newCache.Dir = oldCache.Dir
+		}
+	}
+
+	var tableList []string
+	if *tables != "" {
+		tableList = strings.Split(*tables, ",")
+	}
+	diff, err := defs.Diff(ctx, oldCache, newCache, tableList...)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *jsonOut {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(diff); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	fmt.Printf("%s -> %s\n", diff.OldVersion, diff.NewVersion)
+	for _, td := range diff.Tables {
+		fmt.Printf("\n%s: %d added, %d removed, %d modified\n", td.Table, len(td.Added), len(td.Removed), len(td.Modified))
+		for _, hash := range td.Added {
+			fmt.Printf("  + %d %s\n", hash, name(newCache, td.Table, hash))
+		}
+		for _, hash := range td.Removed {
+			fmt.Printf("  - %d %s\n", hash, name(oldCache, td.Table, hash))
+		}
+		for _, dd := range td.Modified {
+			fmt.Printf("  ~ %d %s\n", dd.Hash, name(newCache, td.Table, dd.Hash))
+			for _, c := range dd.Changes {
+				fmt.Printf("      %s: %s -> %s\n", c.Path, orNone(c.Old), orNone(c.New))
+			}
+		}
+	}
+}
+
+func readManifest(path string) bnet.Manifest {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var mani bnet.Manifest
+	if err := json.Unmarshal(b, &mani); err != nil {
+		log.Fatal(err)
+	}
+	if mani.Version == "" {
+		log.Fatalf("%s: not a manifest saved by -save", path)
+	}
+	return mani
+}
+
+// name returns the display name of a definition, if it has one.
+func name(c *defs.Cache, table string, hash uint32) string {
+	var def struct {
+		DisplayProperties struct {
+			Name string `json:"name"`
+		} `json:"displayProperties"`
+	}
+	if err := c.GetDef(table, hash, &def); err != nil {
+		return ""
+	}
+	return def.DisplayProperties.Name
+}
+
+func orNone(v json.RawMessage) string {
+	if v == nil {
+		return "(none)"
+	}
+	return string(v)
+}
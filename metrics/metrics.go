@@ -0,0 +1,156 @@
+// Package metrics records Prometheus metrics for Bungie.net API calls and definition tables.
+//
+//	m := metrics.New(prometheus.DefaultRegisterer)
+//	api := bnet.NewAPI(key).
+//		WithRateLimiter(bnet.RateLimit{Global: 20, OnWait: m.RateLimitWait}).
+//		WithRetry(bnet.RetryPolicy{OnRetry: m.RetryWait}).
+//		WithInterceptor(m.Interceptor)
+//	cache := defs.NewCache(api)
+//	cache.OnTableLoad = m.TableLoad
+package metrics
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	bnet "github.com/d2orbc/bungie-api-go"
+	"github.com/d2orbc/bungie-api-go/defs"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics holds the collectors. Its methods are safe for concurrent use.
+type Metrics struct {
+	requests        *prometheus.CounterVec
+	errors          *prometheus.CounterVec
+	latency         *prometheus.HistogramVec
+	throttleWaits   *prometheus.CounterVec
+	throttleSeconds *prometheus.GaugeVec
+	tableLoads      *prometheus.CounterVec
+	tableEntries    *prometheus.GaugeVec
+	tableLoadTime   *prometheus.GaugeVec
+}
+
+// New creates the collectors and registers them with reg, or prometheus.DefaultRegisterer if reg is nil.
+// It panics if they are already registered.
+func New(reg prometheus.Registerer) *Metrics {
+	if reg == nil {
+		reg = prometheus.DefaultRegisterer
+	}
+	m := &Metrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "bnet",
+			Name:      "requests_total",
+			Help:      "API calls by operation, HTTP status and Bungie error code.",
+		}, []string{"operation", "status", "error_code"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "bnet",
+			Name:      "request_errors_total",
+			Help:      "Failed API calls by operation, HTTP status and Bungie error code.",
+		}, []string{"operation", "status", "error_code"}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "bnet",
+			Name:      "request_duration_seconds",
+			Help:      "Latency of API calls by operation.",
+			Buckets:   []float64{.05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+		}, []string{"operation"}),
+		throttleWaits: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "bnet",
+			Name:      "throttle_waits_total",
+			Help:      "Calls delayed by the rate limiter or a retry, by operation and source.",
+		}, []string{"operation", "source"}),
+		throttleSeconds: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "bnet",
+			Name:      "throttle_wait_seconds",
+			Help:      "Most recent delay imposed by the rate limiter or a retry, by operation and source.",
+		}, []string{"operation", "source"}),
+		tableLoads: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "bnet",
+			Name:      "defs_table_loads_total",
+			Help:      "Definition table loads by table, locale, source and result.",
+		}, []string{"table", "locale", "source", "result"}),
+		tableEntries: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "bnet",
+			Name:      "defs_table_entries",
+			Help:      "Number of definitions in the most recently loaded version of a table.",
+		}, []string{"table", "locale"}),
+		tableLoadTime: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "bnet",
+			Name:      "defs_table_load_seconds",
+			Help:      "Time taken by the most recent load of a table.",
+		}, []string{"table", "locale"}),
+	}
+	reg.MustRegister(m.requests, m.errors, m.latency, m.throttleWaits, m.throttleSeconds,
+		m.tableLoads, m.tableEntries, m.tableLoadTime)
+	return m
+}
+
+// Interceptor records every call. Pass it to bnet.API.WithInterceptor. Installed last, as in the
+// package example, it measures whole calls including retries and rate limiting; installed first, it
+// measures each HTTP request.
+func (m *Metrics) Interceptor(base bnet.Client) bnet.Client {
+	return bnet.InterceptorFuncClient{Base: base, F: func(base bnet.Client, ctx context.Context, r bnet.ClientRequest, resp any) error {
+		start := time.Now()
+		err := base.Do(ctx, r, resp)
+		m.latency.WithLabelValues(r.Operation).Observe(time.Since(start).Seconds())
+		status, code := labels(resp, err)
+		m.requests.WithLabelValues(r.Operation, status, code).Inc()
+		if err != nil {
+			m.errors.WithLabelValues(r.Operation, status, code).Inc()
+		}
+		return err
+	}}
+}
+
+// labels returns the HTTP status and Bungie error code of a call. Either is "" if unknown, such as
+// for network errors.
+func labels(resp any, err error) (status, code string) {
+	if r, ok := resp.(interface{ Meta() bnet.ResponseMeta }); ok && r.Meta().StatusCode != 0 {
+		status = strconv.Itoa(r.Meta().StatusCode)
+	}
+	var hErr *bnet.HTTPError
+	if errors.As(err, &hErr) {
+		status = strconv.Itoa(hErr.Code)
+	}
+	var bErr *bnet.BungieError
+	switch {
+	case errors.As(err, &bErr):
+		code = bErr.Code.Enum()
+	case err == nil:
+		code = bnet.PlatformErrorCodes_Success.Enum()
+	}
+	return status, code
+}
+
+// RateLimitWait records a delay by the rate limiter. Use it as bnet.RateLimit.OnWait.
+func (m *Metrics) RateLimitWait(operation string, d time.Duration) {
+	m.throttleWait(operation, "rate_limit", d)
+}
+
+// RetryWait records a delay before a retry. Use it as bnet.RetryPolicy.OnRetry.
+func (m *Metrics) RetryWait(operation string, err error, d time.Duration) {
+	m.throttleWait(operation, "retry", d)
+}
+
+func (m *Metrics) throttleWait(operation, source string, d time.Duration) {
+	m.throttleWaits.WithLabelValues(operation, source).Inc()
+	m.throttleSeconds.WithLabelValues(operation, source).Set(d.Seconds())
+}
+
+// TableLoad records the loading of a definition table. Use it as defs.Cache.OnTableLoad.
+func (m *Metrics) TableLoad(l defs.TableLoad) {
+	source := "network"
+	if l.FromDisk {
+		source = "disk"
+	}
+	result := "ok"
+	if l.Err != nil {
+		result = "error"
+	}
+	m.tableLoads.WithLabelValues(l.Table, l.Locale, source, result).Inc()
+	if l.Err == nil {
+		m.tableEntries.WithLabelValues(l.Table, l.Locale).Set(float64(l.Entries))
+		m.tableLoadTime.WithLabelValues(l.Table, l.Locale).Set(l.Duration.Seconds())
+	}
+}
@@ -0,0 +1,37 @@
+package metrics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	bnet "github.com/d2orbc/bungie-api-go"
+	"github.com/d2orbc/bungie-api-go/defs"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestInterceptor(t *testing.T) {
+	m := New(prometheus.NewRegistry())
+	api := (&bnet.API{}).WithInterceptorFunc(func(_ bnet.Client, ctx context.Context, r bnet.ClientRequest, resp any) error {
+		return &bnet.BungieError{Code: bnet.PlatformErrorCodes_SystemDisabled}
+	}).WithInterceptor(m.Interceptor)
+
+	api.Destiny2GetDestinyManifest(context.Background(), bnet.Destiny2GetDestinyManifestRequest{})
+	if n := testutil.ToFloat64(m.errors.WithLabelValues("Destiny2.GetDestinyManifest", "", "SystemDisabled")); n != 1 {
+		t.Fatalf("errors = %v; want 1", n)
+	}
+	if n := testutil.CollectAndCount(m.latency); n != 1 {
+		t.Fatalf("latency series = %d; want 1", n)
+	}
+
+	m.RateLimitWait("Destiny2.EquipItem", 500*time.Millisecond)
+	if s := testutil.ToFloat64(m.throttleSeconds.WithLabelValues("Destiny2.EquipItem", "rate_limit")); s != 0.5 {
+		t.Fatalf("throttle wait = %v; want 0.5", s)
+	}
+
+	m.TableLoad(defs.TableLoad{Locale: "en", Table: "DestinyClassDefinition", Entries: 3})
+	if n := testutil.ToFloat64(m.tableEntries.WithLabelValues("DestinyClassDefinition", "en")); n != 3 {
+		t.Fatalf("entries = %v; want 3", n)
+	}
+}
@@ -0,0 +1,316 @@
+// Package oauth implements the Bungie.net OAuth2 authorization code flow.
+//
+// See https://github.com/Bungie-net/api/wiki/OAuth-Documentation.
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	bnet "github.com/d2orbc/bungie-api-go"
+)
+
+const (
+	DefaultAuthURL  = "https://www.bungie.net/en/OAuth/Authorize"
+	DefaultTokenURL = "https://www.bungie.net/Platform/App/OAuth/token/"
+)
+
+// expiryDelta is how long before its expiry a token is considered expired.
+const expiryDelta = 30 * time.Second
+
+// Config describes a Bungie.net OAuth application.
+type Config struct {
+	ClientID string
+
+	// ClientSecret is empty for public clients.
+	ClientSecret string
+
+	// RedirectURL is optional. Bungie uses the redirect URL registered with the application.
+	RedirectURL string
+
+	// AuthURL defaults to DefaultAuthURL.
+	AuthURL string
+
+	// TokenURL defaults to DefaultTokenURL.
+	TokenURL string
+
+	// HTTPClient defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+// Token is the result of a code exchange or refresh.
+type Token struct {
+	AccessToken   string    `json:"access_token"`
+	TokenType     string    `json:"token_type"`
+	Expiry        time.Time `json:"expiry"`
+	RefreshToken  string    `json:"refresh_token,omitempty"`
+	RefreshExpiry time.Time `json:"refresh_expiry,omitempty"`
+
+	// MembershipID is the Bungie.net membership ID of the user.
+	MembershipID string `json:"membership_id"`
+}
+
+// Valid reports whether t has an access token that is not about to expire.
+func (t *Token) Valid() bool {
+	return t != nil && t.AccessToken != "" && (t.Expiry.IsZero() || time.Now().Add(expiryDelta).Before(t.Expiry))
+}
+
+// CanRefresh reports whether t has a refresh token that has not expired.
+func (t *Token) CanRefresh() bool {
+	return t != nil && t.RefreshToken != "" && (t.RefreshExpiry.IsZero() || time.Now().Before(t.RefreshExpiry))
+}
+
+// TokenError is an error returned by the token endpoint.
+type TokenError struct {
+	Code        int
+	ErrorCode   string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+func (err *TokenError) Error() string {
+	if err.Description != "" {
+		return fmt.Sprintf("oauth: %s: %s", err.ErrorCode, err.Description)
+	}
+	if err.ErrorCode != "" {
+		return "oauth: " + err.ErrorCode
+	}
+	return fmt.Sprintf("oauth: HTTP Error %d", err.Code)
+}
+
+// GenerateState returns a random value for the state parameter of AuthCodeURL.
+func GenerateState() string {
+	return randomString(16)
+}
+
+// GenerateVerifier returns a random PKCE code verifier.
+func GenerateVerifier() string {
+	return randomString(32)
+}
+
+// S256Challenge returns the PKCE code challenge for verifier.
+func S256Challenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func randomString(n int) string {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// AuthCodeURL returns the URL to send the user to for authorization.
+// If verifier is not empty, a PKCE S256 code challenge is included; pass the same verifier to Exchange.
+func (c *Config) AuthCodeURL(state, verifier string) string {
+	v := url.Values{
+		"client_id":     {c.ClientID},
+		"response_type": {"code"},
+	}
+	if state != "" {
+		v.Set("state", state)
+	}
+	if c.RedirectURL != "" {
+		v.Set("redirect_uri", c.RedirectURL)
+	}
+	if verifier != "" {
+		v.Set("code_challenge", S256Challenge(verifier))
+		v.Set("code_challenge_method", "S256")
+	}
+	authURL := c.AuthURL
+	if authURL == "" {
+		authURL = DefaultAuthURL
+	}
+	if strings.Contains(authURL, "?") {
+		return authURL + "&" + v.Encode()
+	}
+	return authURL + "?" + v.Encode()
+}
+
+// Exchange converts an authorization code into a token.
+// verifier is the PKCE code verifier passed to AuthCodeURL, if any.
+func (c *Config) Exchange(ctx context.Context, code, verifier string) (*Token, error) {
+	v := url.Values{
+		"grant_type": {"authorization_code"},
+		"code":       {code},
+	}
+	if verifier != "" {
+		v.Set("code_verifier", verifier)
+	}
+	if c.RedirectURL != "" {
+		v.Set("redirect_uri", c.RedirectURL)
+	}
+	return c.retrieveToken(ctx, v)
+}
+
+// Refresh obtains a new token using refreshToken.
+func (c *Config) Refresh(ctx context.Context, refreshToken string) (*Token, error) {
+	if refreshToken == "" {
+		return nil, errors.New("oauth: missing refresh token")
+	}
+	return c.retrieveToken(ctx, url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {refreshToken},
+	})
+}
+
+type tokenJSON struct {
+	AccessToken      string `json:"access_token"`
+	TokenType        string `json:"token_type"`
+	ExpiresIn        int64  `json:"expires_in"`
+	RefreshToken     string `json:"refresh_token"`
+	RefreshExpiresIn int64  `json:"refresh_expires_in"`
+	MembershipID     string `json:"membership_id"`
+}
+
+func (c *Config) retrieveToken(ctx context.Context, v url.Values) (*Token, error) {
+	if c.ClientSecret == "" {
+		v.Set("client_id", c.ClientID)
+	}
+	tokenURL := c.TokenURL
+	if tokenURL == "" {
+		tokenURL = DefaultTokenURL
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", tokenURL, strings.NewReader(v.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if c.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(c.ClientID), url.QueryEscape(c.ClientSecret))
+	}
+	h := c.HTTPClient
+	if h == nil {
+		h = http.DefaultClient
+	}
+	now := time.Now()
+	resp, err := h.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode > 299 {
+		tErr := &TokenError{Code: resp.StatusCode}
+		json.Unmarshal(body, tErr)
+		return nil, tErr
+	}
+	var tj tokenJSON
+	if err := json.Unmarshal(body, &tj); err != nil {
+		return nil, err
+	}
+	if tj.AccessToken == "" {
+		return nil, errors.New("oauth: server response missing access_token")
+	}
+	tok := &Token{
+		AccessToken:  tj.AccessToken,
+		TokenType:    tj.TokenType,
+		RefreshToken: tj.RefreshToken,
+		MembershipID: tj.MembershipID,
+	}
+	if tj.ExpiresIn > 0 {
+		tok.Expiry = now.Add(time.Duration(tj.ExpiresIn) * time.Second)
+	}
+	if tj.RefreshExpiresIn > 0 {
+		tok.RefreshExpiry = now.Add(time.Duration(tj.RefreshExpiresIn) * time.Second)
+	}
+	return tok, nil
+}
+
+// TokenSource returns a TokenSource that starts with tok and refreshes it as needed.
+func (c *Config) TokenSource(tok *Token) *TokenSource {
+	return &TokenSource{conf: c, tok: tok}
+}
+
+// TokenSource hands out a valid token, refreshing it when it expires.
+// It is safe for concurrent use.
+type TokenSource struct {
+	conf *Config
+
+	mu  sync.Mutex
+	tok *Token
+
+	// OnRefresh, if set, is called with every new token so that it can be persisted.
+	OnRefresh func(*Token)
+}
+
+// Token returns a valid token, refreshing the current one if it has expired.
+func (ts *TokenSource) Token(ctx context.Context) (*Token, error) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	if ts.tok.Valid() {
+		return ts.tok, nil
+	}
+	return ts.refreshLocked(ctx, ts.tok)
+}
+
+// Refresh refreshes the token unless it was already replaced since stale was handed out.
+func (ts *TokenSource) Refresh(ctx context.Context, stale *Token) (*Token, error) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	if ts.tok != stale && ts.tok.Valid() {
+		return ts.tok, nil
+	}
+	return ts.refreshLocked(ctx, ts.tok)
+}
+
+func (ts *TokenSource) refreshLocked(ctx context.Context, old *Token) (*Token, error) {
+	if !old.CanRefresh() {
+		return nil, errors.New("oauth: token expired and cannot be refreshed")
+	}
+	tok, err := ts.conf.Refresh(ctx, old.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	if tok.MembershipID == "" {
+		tok.MembershipID = old.MembershipID
+	}
+	ts.tok = tok
+	if ts.OnRefresh != nil {
+		ts.OnRefresh(tok)
+	}
+	return tok, nil
+}
+
+// Interceptor returns an interceptor for bnet.API.WithInterceptor that authorizes every request
+// with a token from ts. If Bungie reports that the token has expired, it is refreshed and the
+// request is retried once.
+func Interceptor(ts *TokenSource) func(bnet.Client) bnet.Client {
+	return func(base bnet.Client) bnet.Client {
+		return bnet.InterceptorFuncClient{Base: base, F: func(base bnet.Client, ctx context.Context, r bnet.ClientRequest, resp any) error {
+			// Refresh compares tokens by identity, so remember the one that was sent.
+			var sent *Token
+			return bnet.DoAuthorized(ctx, base, r, resp,
+				func(ctx context.Context) (string, error) {
+					tok, err := ts.Token(ctx)
+					if err != nil {
+						return "", err
+					}
+					sent = tok
+					return tok.AccessToken, nil
+				},
+				func(ctx context.Context, _ string) (string, error) {
+					tok, err := ts.Refresh(ctx, sent)
+					if err != nil {
+						return "", err
+					}
+					return tok.AccessToken, nil
+				})
+		}}
+	}
+}
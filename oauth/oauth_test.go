@@ -0,0 +1,103 @@
+package oauth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	bnet "github.com/d2orbc/bungie-api-go"
+)
+
+func TestExchangeAndRefresh(t *testing.T) {
+	var refreshes int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		if id, secret, ok := r.BasicAuth(); !ok || id != "123" || secret != "s3cret" {
+			t.Errorf("basic auth = %q, %q, %v", id, secret, ok)
+		}
+		switch r.Form.Get("grant_type") {
+		case "authorization_code":
+			if r.Form.Get("code") != "abc" {
+				w.WriteHeader(400)
+				fmt.Fprint(w, `{"error":"invalid_grant","error_description":"AuthorizationCodeInvalid"}`)
+				return
+			}
+			fmt.Fprint(w, `{"access_token":"at0","token_type":"Bearer","expires_in":1,"refresh_token":"rt0","refresh_expires_in":7776000,"membership_id":"42"}`)
+		case "refresh_token":
+			refreshes++
+			fmt.Fprintf(w, `{"access_token":"at%d","token_type":"Bearer","expires_in":3600,"refresh_token":"rt%d","refresh_expires_in":7776000}`, refreshes, refreshes)
+		}
+	}))
+	defer srv.Close()
+
+	conf := &Config{ClientID: "123", ClientSecret: "s3cret", TokenURL: srv.URL}
+	ctx := context.Background()
+
+	if _, err := conf.Exchange(ctx, "wrong", ""); err == nil {
+		t.Fatal("want error for bad code")
+	}
+	tok, err := conf.Exchange(ctx, "abc", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tok.MembershipID != "42" || tok.Valid() {
+		t.Fatalf("tok = %+v; want short-lived token for 42", tok)
+	}
+
+	ts := conf.TokenSource(tok)
+	tok, err = ts.Token(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tok.AccessToken != "at1" || tok.MembershipID != "42" {
+		t.Fatalf("tok = %+v; want refreshed token", tok)
+	}
+	if !tok.Expiry.After(time.Now().Add(time.Hour - time.Minute)) {
+		t.Fatalf("expiry = %v", tok.Expiry)
+	}
+	if _, err := ts.Token(ctx); err != nil || refreshes != 1 {
+		t.Fatalf("refreshes = %d, err = %v; want 1 refresh", refreshes, err)
+	}
+}
+
+func TestAuthCodeURL(t *testing.T) {
+	conf := &Config{ClientID: "123"}
+	got := conf.AuthCodeURL("xyz", "verifier")
+	want := DefaultAuthURL + "?client_id=123&code_challenge=" + S256Challenge("verifier") +
+		"&code_challenge_method=S256&response_type=code&state=xyz"
+	if got != want {
+		t.Fatalf("got %s; want %s", got, want)
+	}
+}
+
+func TestInterceptorRetry(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			fmt.Fprint(w, `{"access_token":"at1","token_type":"Bearer","expires_in":3600,"refresh_token":"rt1","refresh_expires_in":7776000}`)
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer at1" {
+			fmt.Fprint(w, `{"ErrorCode":2111,"ErrorStatus":"AccessTokenHasExpired","MessageData":{"stale":"yes"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"ErrorCode":1,"ErrorStatus":"Success","Response":{"version":"v1"}}`)
+	}))
+	defer srv.Close()
+
+	conf := &Config{ClientID: "123", TokenURL: srv.URL + "/token"}
+	ts := conf.TokenSource(&Token{AccessToken: "at0", RefreshToken: "rt0", Expiry: time.Now().Add(time.Hour)})
+	api := bnet.NewAPI("key").WithBaseURL(srv.URL + "/Platform").WithInterceptor(Interceptor(ts))
+
+	resp, err := api.Destiny2GetDestinyManifest(context.Background(), bnet.Destiny2GetDestinyManifestRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Response.Version != "v1" || resp.MessageData["stale"] != "" {
+		t.Fatalf("resp = %+v; want only the retried response", resp)
+	}
+}
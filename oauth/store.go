@@ -0,0 +1,232 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	bnet "github.com/d2orbc/bungie-api-go"
+)
+
+// ErrNoToken is returned by a TokenStore that has no token for a user.
+var ErrNoToken = errors.New("oauth: no token for user")
+
+// TokenStore persists tokens keyed by Bungie.net membership ID.
+// Implementations must be safe for concurrent use.
+type TokenStore interface {
+	// Get returns the token for the user, or ErrNoToken.
+	Get(ctx context.Context, membershipID bnet.Int64) (*Token, error)
+	Put(ctx context.Context, membershipID bnet.Int64, tok *Token) error
+	Delete(ctx context.Context, membershipID bnet.Int64) error
+}
+
+// NewMemoryStore returns a TokenStore that keeps tokens in memory.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{m: make(map[bnet.Int64]*Token)}
+}
+
+type MemoryStore struct {
+	mu sync.Mutex
+	m  map[bnet.Int64]*Token
+}
+
+func (s *MemoryStore) Get(ctx context.Context, membershipID bnet.Int64) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tok, ok := s.m[membershipID]
+	if !ok {
+		return nil, ErrNoToken
+	}
+	return tok, nil
+}
+
+func (s *MemoryStore) Put(ctx context.Context, membershipID bnet.Int64, tok *Token) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m[membershipID] = tok
+	return nil
+}
+
+func (s *MemoryStore) Delete(ctx context.Context, membershipID bnet.Int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.m, membershipID)
+	return nil
+}
+
+// NewFileStore returns a TokenStore that keeps one JSON file per user in dir.
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	return &FileStore{dir: dir}, nil
+}
+
+type FileStore struct {
+	dir string
+}
+
+func (s *FileStore) path(membershipID bnet.Int64) string {
+	return filepath.Join(s.dir, fmt.Sprintf("%d.json", membershipID))
+}
+
+func (s *FileStore) Get(ctx context.Context, membershipID bnet.Int64) (*Token, error) {
+	b, err := os.ReadFile(s.path(membershipID))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNoToken
+	}
+	if err != nil {
+		return nil, err
+	}
+	var tok Token
+	if err := json.Unmarshal(b, &tok); err != nil {
+		return nil, err
+	}
+	return &tok, nil
+}
+
+// Put writes the token to a temporary file and renames it into place,
+// so that a concurrent Get never sees a partial file.
+func (s *FileStore) Put(ctx context.Context, membershipID bnet.Int64, tok *Token) error {
+	b, err := json.Marshal(tok)
+	if err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(s.dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), s.path(membershipID))
+}
+
+func (s *FileStore) Delete(ctx context.Context, membershipID bnet.Int64) error {
+	err := os.Remove(s.path(membershipID))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// NewSessions returns a bnet.UserTokenSource that serves tokens from store and refreshes them using conf.
+//
+//	sessions := oauth.NewSessions(conf, store)
+//	api := bnet.NewAPI(key).WithUserTokens(sessions)
+//	api.ForUser(membershipID).Destiny2EquipItem(ctx, req)
+func NewSessions(conf *Config, store TokenStore) *Sessions {
+	return &Sessions{conf: conf, store: store, inflight: make(map[bnet.Int64]*refreshCall)}
+}
+
+// Sessions manages tokens for many users. Concurrent refreshes for the same user are coalesced so
+// that a refresh token is never used twice.
+type Sessions struct {
+	conf  *Config
+	store TokenStore
+
+	mu       sync.Mutex
+	inflight map[bnet.Int64]*refreshCall
+}
+
+type refreshCall struct {
+	done chan struct{}
+	tok  *Token
+	err  error
+}
+
+// Put stores the token obtained from Exchange for its user.
+func (s *Sessions) Put(ctx context.Context, tok *Token) error {
+	id, err := strconv.ParseInt(tok.MembershipID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("oauth: bad membership ID %q: %w", tok.MembershipID, err)
+	}
+	return s.store.Put(ctx, bnet.Int64(id), tok)
+}
+
+// AccessToken implements bnet.UserTokenSource.
+func (s *Sessions) AccessToken(ctx context.Context, membershipID bnet.Int64) (string, error) {
+	tok, err := s.store.Get(ctx, membershipID)
+	if err != nil {
+		return "", err
+	}
+	if tok.Valid() {
+		return tok.AccessToken, nil
+	}
+	tok, err = s.refresh(ctx, membershipID, tok.AccessToken)
+	if err != nil {
+		return "", err
+	}
+	return tok.AccessToken, nil
+}
+
+// RefreshAccessToken implements bnet.UserTokenSource.
+func (s *Sessions) RefreshAccessToken(ctx context.Context, membershipID bnet.Int64, stale string) (string, error) {
+	tok, err := s.refresh(ctx, membershipID, stale)
+	if err != nil {
+		return "", err
+	}
+	return tok.AccessToken, nil
+}
+
+// refresh refreshes the user's token, unless the stored token has already been replaced since stale
+// was handed out. Concurrent calls for the same user share a single refresh.
+func (s *Sessions) refresh(ctx context.Context, membershipID bnet.Int64, stale string) (*Token, error) {
+	s.mu.Lock()
+	if c, ok := s.inflight[membershipID]; ok {
+		s.mu.Unlock()
+		select {
+		case <-c.done:
+			return c.tok, c.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	c := &refreshCall{done: make(chan struct{})}
+	s.inflight[membershipID] = c
+	s.mu.Unlock()
+
+	// The refresh itself is not tied to ctx: other callers may be waiting on it, and an
+	// abandoned refresh could burn the refresh token without storing the new one.
+	c.tok, c.err = s.doRefresh(context.WithoutCancel(ctx), membershipID, stale)
+
+	s.mu.Lock()
+	delete(s.inflight, membershipID)
+	s.mu.Unlock()
+	close(c.done)
+	return c.tok, c.err
+}
+
+func (s *Sessions) doRefresh(ctx context.Context, membershipID bnet.Int64, stale string) (*Token, error) {
+	old, err := s.store.Get(ctx, membershipID)
+	if err != nil {
+		return nil, err
+	}
+	if old.AccessToken != stale && old.Valid() {
+		return old, nil
+	}
+	if !old.CanRefresh() {
+		return nil, errors.New("oauth: token expired and cannot be refreshed")
+	}
+	tok, err := s.conf.Refresh(ctx, old.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	if tok.MembershipID == "" {
+		tok.MembershipID = old.MembershipID
+	}
+	if err := s.store.Put(ctx, membershipID, tok); err != nil {
+		return nil, err
+	}
+	return tok, nil
+}
@@ -0,0 +1,61 @@
+package oauth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	bnet "github.com/d2orbc/bungie-api-go"
+)
+
+func TestSessionsCoalesceRefresh(t *testing.T) {
+	var refreshes atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := refreshes.Add(1)
+		time.Sleep(10 * time.Millisecond)
+		fmt.Fprintf(w, `{"access_token":"at%d","expires_in":3600,"refresh_token":"rt%d","refresh_expires_in":7776000}`, n, n)
+	}))
+	defer srv.Close()
+
+	store, err := NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	sessions := NewSessions(&Config{ClientID: "123", TokenURL: srv.URL}, store)
+	ctx := context.Background()
+	expired := &Token{AccessToken: "at0", Expiry: time.Now(), RefreshToken: "rt0", MembershipID: "42"}
+	if err := sessions.Put(ctx, expired); err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if tok, err := sessions.AccessToken(ctx, 42); err != nil || tok != "at1" {
+				t.Errorf("AccessToken = %q, %v; want at1", tok, err)
+			}
+		}()
+	}
+	wg.Wait()
+	if n := refreshes.Load(); n != 1 {
+		t.Fatalf("refreshes = %d; want 1", n)
+	}
+
+	stored, err := store.Get(ctx, 42)
+	if err != nil || stored.RefreshToken != "rt1" || stored.MembershipID != "42" {
+		t.Fatalf("stored = %+v, %v", stored, err)
+	}
+	if err := store.Delete(ctx, 42); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sessions.AccessToken(ctx, bnet.Int64(42)); err != ErrNoToken {
+		t.Fatalf("err = %v; want ErrNoToken", err)
+	}
+}
@@ -0,0 +1,83 @@
+// Package otelbnet traces Bungie.net API calls with OpenTelemetry.
+//
+//	api := bnet.NewAPI(key).WithTracer(otelbnet.NewTracer())
+package otelbnet
+
+import (
+	"context"
+
+	bnet "github.com/d2orbc/bungie-api-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/d2orbc/bungie-api-go/otelbnet"
+
+// Option configures a Tracer.
+type Option func(*Tracer)
+
+// WithTracerProvider sets the provider of spans. The default is the global provider.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(t *Tracer) { t.tracer = tp.Tracer(instrumentationName) }
+}
+
+// WithPropagator sets the propagator used to add trace headers to requests. The default is the
+// global propagator.
+func WithPropagator(p propagation.TextMapPropagator) Option {
+	return func(t *Tracer) { t.propagator = p }
+}
+
+// NewTracer returns a bnet.Tracer that records a client span for every call.
+func NewTracer(opts ...Option) *Tracer {
+	t := &Tracer{}
+	for _, opt := range opts {
+		opt(t)
+	}
+	if t.tracer == nil {
+		t.tracer = otel.GetTracerProvider().Tracer(instrumentationName)
+	}
+	if t.propagator == nil {
+		t.propagator = otel.GetTextMapPropagator()
+	}
+	return t
+}
+
+// Tracer implements bnet.Tracer.
+type Tracer struct {
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+}
+
+var _ bnet.Tracer = (*Tracer)(nil)
+
+func (t *Tracer) Start(ctx context.Context, call *bnet.Call) context.Context {
+	attrs := []attribute.KeyValue{
+		attribute.String("bnet.operation", call.Operation),
+		attribute.String("http.request.method", call.Method),
+		attribute.String("url.template", call.PathSpec),
+	}
+	if call.MembershipType != bnet.BungieMembershipType_None {
+		attrs = append(attrs, attribute.String("bnet.membership_type", call.MembershipType.Enum()))
+	}
+	ctx, _ = t.tracer.Start(ctx, call.Operation, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+	t.propagator.Inject(ctx, propagation.MapCarrier(call.Header))
+	return ctx
+}
+
+func (t *Tracer) Finish(ctx context.Context, call *bnet.Call) {
+	span := trace.SpanFromContext(ctx)
+	if call.StatusCode != 0 {
+		span.SetAttributes(attribute.Int("http.response.status_code", call.StatusCode))
+	}
+	if call.ErrorCode != 0 {
+		span.SetAttributes(attribute.String("bnet.error_code", call.ErrorCode.Enum()))
+	}
+	if call.Err != nil {
+		span.RecordError(call.Err)
+		span.SetStatus(codes.Error, call.Err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,54 @@
+package otelbnet
+
+import (
+	"context"
+	"testing"
+
+	bnet "github.com/d2orbc/bungie-api-go"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracer(t *testing.T) {
+	rec := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec))
+	tracer := NewTracer(WithTracerProvider(tp), WithPropagator(propagation.TraceContext{}))
+
+	var traceparent string
+	api := (&bnet.API{}).WithInterceptorFunc(func(_ bnet.Client, ctx context.Context, r bnet.ClientRequest, resp any) error {
+		traceparent = r.Headers["traceparent"]
+		return &bnet.BungieError{Code: bnet.PlatformErrorCodes_DestinyAccountNotFound}
+	}).WithTracer(tracer)
+	api.Destiny2GetProfile(context.Background(), bnet.Destiny2GetProfileRequest{
+		MembershipType:      bnet.BungieMembershipType_TigerSteam,
+		DestinyMembershipID: 1,
+	})
+
+	spans := rec.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("spans = %d; want 1", len(spans))
+	}
+	span := spans[0]
+	if span.Name() != "Destiny2.GetProfile" || span.Status().Code != codes.Error {
+		t.Fatalf("span = %s %v", span.Name(), span.Status())
+	}
+	want := map[attribute.Key]string{
+		"bnet.membership_type": "TigerSteam",
+		"bnet.error_code":      "DestinyAccountNotFound",
+		"url.template":         "/Destiny2/{membershipType}/Profile/{destinyMembershipId}/",
+	}
+	for _, kv := range span.Attributes() {
+		if v, ok := want[kv.Key]; ok && kv.Value.AsString() == v {
+			delete(want, kv.Key)
+		}
+	}
+	if len(want) > 0 {
+		t.Fatalf("missing attributes %v in %v", want, span.Attributes())
+	}
+	if traceparent == "" || span.SpanContext().TraceID().String() != traceparent[3:35] {
+		t.Fatalf("traceparent = %q; want trace %s", traceparent, span.SpanContext().TraceID())
+	}
+}
@@ -4,12 +4,19 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"time"
 )
 
 // GetUserSystemOverridesRequest are the request parameters for operation .GetUserSystemOverrides
 type GetUserSystemOverridesRequest struct {
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r GetUserSystemOverridesRequest) Validate() error {
+	return nil
+}
+
 // GetUserSystemOverrides: Get the user-specific system overrides that should be respected alongside
 // common systems.
 //
@@ -30,6 +37,11 @@ func (a API) GetUserSystemOverrides(ctx context.Context, req GetUserSystemOverri
 	//	  ]
 	//	}
 	var resp ServerResponse[map[string]CoreSystem]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: ".GetUserSystemOverrides",
 		Method:   "GET",
 		PathSpec: "/UserSystemOverrides/", PathParams: map[string]string{}, QueryParams: url.Values{}}, &resp)
@@ -41,6 +53,12 @@ func (a API) GetUserSystemOverrides(ctx context.Context, req GetUserSystemOverri
 type UserGetMembershipDataForCurrentUserRequest struct {
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r UserGetMembershipDataForCurrentUserRequest) Validate() error {
+	return nil
+}
+
 // UserGetMembershipDataForCurrentUser: Returns a list of accounts associated with signed in user. This
 // is useful for OAuth implementations that do not give you access to the token response.
 //
@@ -70,6 +88,11 @@ func (a API) UserGetMembershipDataForCurrentUser(ctx context.Context, req UserGe
 	//	  ]
 	//	}
 	var resp ServerResponse[UserMembershipData]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "User.GetMembershipDataForCurrentUser",
 		Method:   "GET",
 		PathSpec: "/User/GetMembershipsForCurrentUser/", PathParams: map[string]string{}, QueryParams: url.Values{}}, &resp)
@@ -80,6 +103,12 @@ func (a API) UserGetMembershipDataForCurrentUser(ctx context.Context, req UserGe
 type UserGetAvailableThemesRequest struct {
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r UserGetAvailableThemesRequest) Validate() error {
+	return nil
+}
+
 // UserGetAvailableThemes: Returns a list of all available user themes.
 //
 // URL: /User/GetAvailableThemes/
@@ -99,6 +128,11 @@ func (a API) UserGetAvailableThemes(ctx context.Context, req UserGetAvailableThe
 	//	  ]
 	//	}
 	var resp ServerResponse[[]UserTheme]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "User.GetAvailableThemes",
 		Method:   "GET",
 		PathSpec: "/User/GetAvailableThemes/", PathParams: map[string]string{}, QueryParams: url.Values{}}, &resp)
@@ -110,6 +144,12 @@ func (a API) UserGetAvailableThemes(ctx context.Context, req UserGetAvailableThe
 type TrendingGetTrendingCategoriesRequest struct {
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r TrendingGetTrendingCategoriesRequest) Validate() error {
+	return nil
+}
+
 // TrendingGetTrendingCategories: Returns trending items for Bungie.net, collapsed into the first page
 // of items per category. For pagination within a category, call GetTrendingCategory.
 //
@@ -130,6 +170,11 @@ func (a API) TrendingGetTrendingCategories(ctx context.Context, req TrendingGetT
 	//	  ]
 	//	}
 	var resp ServerResponse[TrendingCategories]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Trending.GetTrendingCategories",
 		Method:   "GET",
 		PathSpec: "/Trending/Categories/", PathParams: map[string]string{}, QueryParams: url.Values{}}, &resp)
@@ -141,6 +186,12 @@ func (a API) TrendingGetTrendingCategories(ctx context.Context, req TrendingGetT
 type TokensGetBungieRewardsListRequest struct {
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r TokensGetBungieRewardsListRequest) Validate() error {
+	return nil
+}
+
 // TokensGetBungieRewardsList: Returns a list of the current bungie rewards
 //
 // URL: /Tokens/Rewards/BungieRewards/
@@ -160,6 +211,11 @@ func (a API) TokensGetBungieRewardsList(ctx context.Context, req TokensGetBungie
 	//	  ]
 	//	}
 	var resp ServerResponse[map[string]BungieRewardDisplay]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Tokens.GetBungieRewardsList",
 		Method:   "GET",
 		PathSpec: "/Tokens/Rewards/BungieRewards/", PathParams: map[string]string{}, QueryParams: url.Values{}}, &resp)
@@ -170,6 +226,12 @@ func (a API) TokensGetBungieRewardsList(ctx context.Context, req TokensGetBungie
 type TokensForceDropsRepairRequest struct {
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r TokensForceDropsRepairRequest) Validate() error {
+	return nil
+}
+
 // TokensForceDropsRepair: Twitch Drops self-repair function - scans twitch for drops not marked as
 // fulfilled and resyncs them.
 //
@@ -199,6 +261,11 @@ func (a API) TokensForceDropsRepair(ctx context.Context, req TokensForceDropsRep
 	//	  ]
 	//	}
 	var resp ServerResponse[bool]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Tokens.ForceDropsRepair",
 		Method:   "POST",
 		PathSpec: "/Tokens/Partner/ForceDropsRepair/", PathParams: map[string]string{}, QueryParams: url.Values{}}, &resp)
@@ -212,6 +279,12 @@ type TokensClaimPartnerOfferRequest struct {
 	Body PartnerOfferClaimRequestBody
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r TokensClaimPartnerOfferRequest) Validate() error {
+	return nil
+}
+
 // TokensClaimPartnerOffer: Claim a partner offer as the authenticated user.
 //
 // URL: /Tokens/Partner/ClaimOffer/
@@ -250,6 +323,11 @@ func (a API) TokensClaimPartnerOffer(ctx context.Context, req TokensClaimPartner
 	//	  ]
 	//	}
 	var resp ServerResponse[bool]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Tokens.ClaimPartnerOffer",
 		Method:   "POST",
 		PathSpec: "/Tokens/Partner/ClaimOffer/", PathParams: map[string]string{}, QueryParams: url.Values{}, Body: req.Body}, &resp)
@@ -261,6 +339,12 @@ func (a API) TokensClaimPartnerOffer(ctx context.Context, req TokensClaimPartner
 type SocialGetFriendRequestListRequest struct {
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r SocialGetFriendRequestListRequest) Validate() error {
+	return nil
+}
+
 // SocialGetFriendRequestList: Returns your friend request queue.
 //
 // URL: /Social/Friends/Requests/
@@ -289,6 +373,11 @@ func (a API) SocialGetFriendRequestList(ctx context.Context, req SocialGetFriend
 	//	  ]
 	//	}
 	var resp ServerResponse[BungieFriendRequestListResponse]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Social.GetFriendRequestList",
 		Method:   "GET",
 		PathSpec: "/Social/Friends/Requests/", PathParams: map[string]string{}, QueryParams: url.Values{}}, &resp)
@@ -299,6 +388,12 @@ func (a API) SocialGetFriendRequestList(ctx context.Context, req SocialGetFriend
 type SocialGetFriendListRequest struct {
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r SocialGetFriendListRequest) Validate() error {
+	return nil
+}
+
 // SocialGetFriendList: Returns your Bungie Friend list
 //
 // URL: /Social/Friends/
@@ -327,6 +422,11 @@ func (a API) SocialGetFriendList(ctx context.Context, req SocialGetFriendListReq
 	//	  ]
 	//	}
 	var resp ServerResponse[BungieFriendListResponse]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Social.GetFriendList",
 		Method:   "GET",
 		PathSpec: "/Social/Friends/", PathParams: map[string]string{}, QueryParams: url.Values{}}, &resp)
@@ -337,6 +437,12 @@ func (a API) SocialGetFriendList(ctx context.Context, req SocialGetFriendListReq
 type GetCommonSettingsRequest struct {
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r GetCommonSettingsRequest) Validate() error {
+	return nil
+}
+
 // GetCommonSettings: Get the common settings used by the Bungie.Net environment.
 //
 // URL: /Settings/
@@ -356,6 +462,11 @@ func (a API) GetCommonSettings(ctx context.Context, req GetCommonSettingsRequest
 	//	  ]
 	//	}
 	var resp ServerResponse[CoreSettingsConfiguration]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: ".GetCommonSettings",
 		Method:   "GET",
 		PathSpec: "/Settings/", PathParams: map[string]string{}, QueryParams: url.Values{}}, &resp)
@@ -369,6 +480,12 @@ type GroupV2GroupSearchRequest struct {
 	Body GroupQuery
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r GroupV2GroupSearchRequest) Validate() error {
+	return nil
+}
+
 // GroupV2GroupSearch: Search for Groups.
 //
 // URL: /GroupV2/Search/
@@ -398,12 +515,37 @@ func (a API) GroupV2GroupSearch(ctx context.Context, req GroupV2GroupSearchReque
 	//	  ]
 	//	}
 	var resp ServerResponse[GroupSearchResponse]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "GroupV2.GroupSearch",
 		Method:   "POST",
 		PathSpec: "/GroupV2/Search/", PathParams: map[string]string{}, QueryParams: url.Values{}, Body: req.Body}, &resp)
 	return &resp, err
 }
 
+// GroupV2GroupSearchAll returns an iterator over the results of every page of GroupV2GroupSearch,
+// starting at the page in req. See Paginate.
+func (a API) GroupV2GroupSearchAll(ctx context.Context, req GroupV2GroupSearchRequest) func(yield func(GroupV2Card, error) bool) {
+	return Paginate(ctx, req.Body.CurrentPage, func(ctx context.Context, page int32, token string) (Page[GroupV2Card], error) {
+		req.Body.CurrentPage = page
+		if token != "" {
+			req.Body.RequestContinuationToken = token
+		}
+		resp, err := a.GroupV2GroupSearch(ctx, req)
+		if err != nil {
+			return Page[GroupV2Card]{}, err
+		}
+		return Page[GroupV2Card]{
+			Results:           resp.Response.Results,
+			HasMore:           resp.Response.HasMore,
+			ContinuationToken: resp.Response.ReplacementContinuationToken,
+		}, nil
+	})
+}
+
 // GroupV2GetGroupByNameV2Request are the request parameters for operation GroupV2.GetGroupByNameV2
 type GroupV2GetGroupByNameV2Request struct {
 
@@ -411,6 +553,12 @@ type GroupV2GetGroupByNameV2Request struct {
 	Body GroupNameSearchRequestBody
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r GroupV2GetGroupByNameV2Request) Validate() error {
+	return nil
+}
+
 // GroupV2GetGroupByNameV2: Get information about a specific group with the given name and type. The
 // POST version.
 //
@@ -441,6 +589,11 @@ func (a API) GroupV2GetGroupByNameV2(ctx context.Context, req GroupV2GetGroupByN
 	//	  ]
 	//	}
 	var resp ServerResponse[GroupResponse]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "GroupV2.GetGroupByNameV2",
 		Method:   "POST",
 		PathSpec: "/GroupV2/NameV2/", PathParams: map[string]string{}, QueryParams: url.Values{}, Body: req.Body}, &resp)
@@ -451,6 +604,12 @@ func (a API) GroupV2GetGroupByNameV2(ctx context.Context, req GroupV2GetGroupByN
 type GroupV2GetAvailableThemesRequest struct {
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r GroupV2GetAvailableThemesRequest) Validate() error {
+	return nil
+}
+
 // GroupV2GetAvailableThemes: Returns a list of all available group themes.
 //
 // URL: /GroupV2/GetAvailableThemes/
@@ -470,6 +629,11 @@ func (a API) GroupV2GetAvailableThemes(ctx context.Context, req GroupV2GetAvaila
 	//	  ]
 	//	}
 	var resp ServerResponse[[]GroupTheme]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "GroupV2.GetAvailableThemes",
 		Method:   "GET",
 		PathSpec: "/GroupV2/GetAvailableThemes/", PathParams: map[string]string{}, QueryParams: url.Values{}}, &resp)
@@ -481,6 +645,12 @@ func (a API) GroupV2GetAvailableThemes(ctx context.Context, req GroupV2GetAvaila
 type GroupV2GetAvailableAvatarsRequest struct {
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r GroupV2GetAvailableAvatarsRequest) Validate() error {
+	return nil
+}
+
 // GroupV2GetAvailableAvatars: Returns a list of all available group avatars for the signed-in user.
 //
 // URL: /GroupV2/GetAvailableAvatars/
@@ -500,6 +670,11 @@ func (a API) GroupV2GetAvailableAvatars(ctx context.Context, req GroupV2GetAvail
 	//	  ]
 	//	}
 	var resp ServerResponse[map[int32]string]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "GroupV2.GetAvailableAvatars",
 		Method:   "GET",
 		PathSpec: "/GroupV2/GetAvailableAvatars/", PathParams: map[string]string{}, QueryParams: url.Values{}}, &resp)
@@ -513,6 +688,12 @@ type GetGlobalAlertsRequest struct {
 	Includestreaming bool
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r GetGlobalAlertsRequest) Validate() error {
+	return nil
+}
+
 // GetGlobalAlerts: Gets any active global alert for display in the forum banners, help pages, etc.
 // Usually used for DOC alerts.
 //
@@ -543,6 +724,11 @@ func (a API) GetGlobalAlerts(ctx context.Context, req GetGlobalAlertsRequest) (*
 	//	  ]
 	//	}
 	var resp ServerResponse[[]GlobalAlert]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: ".GetGlobalAlerts",
 		Method:   "GET",
 		PathSpec: "/GlobalAlerts/", PathParams: map[string]string{}, QueryParams: url.Values{
@@ -555,6 +741,12 @@ func (a API) GetGlobalAlerts(ctx context.Context, req GetGlobalAlertsRequest) (*
 type GetAvailableLocalesRequest struct {
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r GetAvailableLocalesRequest) Validate() error {
+	return nil
+}
+
 // GetAvailableLocales: List of available localization cultures
 //
 // URL: /GetAvailableLocales/
@@ -574,6 +766,11 @@ func (a API) GetAvailableLocales(ctx context.Context, req GetAvailableLocalesReq
 	//	  ]
 	//	}
 	var resp ServerResponse[map[string]string]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: ".GetAvailableLocales",
 		Method:   "GET",
 		PathSpec: "/GetAvailableLocales/", PathParams: map[string]string{}, QueryParams: url.Values{}}, &resp)
@@ -588,6 +785,12 @@ type ForumGetRecruitmentThreadSummariesRequest struct {
 	Body []Int64
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r ForumGetRecruitmentThreadSummariesRequest) Validate() error {
+	return nil
+}
+
 // ForumGetRecruitmentThreadSummaries: Allows the caller to get a list of to 25 recruitment thread
 // summary information objects.
 //
@@ -622,6 +825,11 @@ func (a API) ForumGetRecruitmentThreadSummaries(ctx context.Context, req ForumGe
 	//	  ]
 	//	}
 	var resp ServerResponse[[]ForumRecruitmentDetail]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Forum.GetRecruitmentThreadSummaries",
 		Method:   "POST",
 		PathSpec: "/Forum/Recruit/Summaries/", PathParams: map[string]string{}, QueryParams: url.Values{}, Body: req.Body}, &resp)
@@ -636,6 +844,12 @@ type ForumGetForumTagSuggestionsRequest struct {
 	Partialtag string
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r ForumGetForumTagSuggestionsRequest) Validate() error {
+	return nil
+}
+
 // ForumGetForumTagSuggestions: Gets tag suggestions based on partial text entry, matching them with
 // other tags previously used in the forums.
 //
@@ -666,6 +880,11 @@ func (a API) ForumGetForumTagSuggestions(ctx context.Context, req ForumGetForumT
 	//	  ]
 	//	}
 	var resp ServerResponse[[]TagResponse]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Forum.GetForumTagSuggestions",
 		Method:   "GET",
 		PathSpec: "/Forum/GetForumTagSuggestions/", PathParams: map[string]string{}, QueryParams: url.Values{
@@ -683,6 +902,17 @@ type Destiny2GetPublicVendorsRequest struct {
 	Components []ComponentType
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2GetPublicVendorsRequest) Validate() error {
+	for _, v := range r.Components {
+		if !v.Valid() {
+			return invalidRequest("Destiny2GetPublicVendorsRequest", "Components", fmt.Sprintf("undefined ComponentType %d", v))
+		}
+	}
+	return nil
+}
+
 // Destiny2GetPublicVendors: Get items available from vendors where the vendors have items for sale
 // that are common for everyone. If any portion of the Vendor's available inventory is character or
 // account specific, we will be unable to return their data from this endpoint due to the way that
@@ -726,6 +956,11 @@ func (a API) Destiny2GetPublicVendors(ctx context.Context, req Destiny2GetPublic
 	//	  "x-preview": true
 	//	}
 	var resp ServerResponse[PublicVendorsResponse]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.GetPublicVendors",
 		Method:   "GET",
 		PathSpec: "/Destiny2/Vendors/", PathParams: map[string]string{}, QueryParams: url.Values{
@@ -739,6 +974,12 @@ func (a API) Destiny2GetPublicVendors(ctx context.Context, req Destiny2GetPublic
 type Destiny2GetHistoricalStatsDefinitionRequest struct {
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2GetHistoricalStatsDefinitionRequest) Validate() error {
+	return nil
+}
+
 // Destiny2GetHistoricalStatsDefinition: Gets historical stats definitions.
 //
 // URL: /Destiny2/Stats/Definition/
@@ -758,6 +999,11 @@ func (a API) Destiny2GetHistoricalStatsDefinition(ctx context.Context, req Desti
 	//	  ]
 	//	}
 	var resp ServerResponse[map[string]HistoricalStatsDefinition]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.GetHistoricalStatsDefinition",
 		Method:   "GET",
 		PathSpec: "/Destiny2/Stats/Definition/", PathParams: map[string]string{}, QueryParams: url.Values{}}, &resp)
@@ -769,6 +1015,12 @@ func (a API) Destiny2GetHistoricalStatsDefinition(ctx context.Context, req Desti
 type Destiny2GetPublicMilestonesRequest struct {
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2GetPublicMilestonesRequest) Validate() error {
+	return nil
+}
+
 // Destiny2GetPublicMilestones: Gets public information about currently available Milestones.
 //
 // URL: /Destiny2/Milestones/
@@ -788,6 +1040,11 @@ func (a API) Destiny2GetPublicMilestones(ctx context.Context, req Destiny2GetPub
 	//	  ]
 	//	}
 	var resp ServerResponse[map[uint32]PublicMilestone]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.GetPublicMilestones",
 		Method:   "GET",
 		PathSpec: "/Destiny2/Milestones/", PathParams: map[string]string{}, QueryParams: url.Values{}}, &resp)
@@ -799,6 +1056,12 @@ func (a API) Destiny2GetPublicMilestones(ctx context.Context, req Destiny2GetPub
 type Destiny2GetDestinyManifestRequest struct {
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2GetDestinyManifestRequest) Validate() error {
+	return nil
+}
+
 // Destiny2GetDestinyManifest: Returns the current version of the manifest as a json object.
 //
 // URL: /Destiny2/Manifest/
@@ -818,6 +1081,11 @@ func (a API) Destiny2GetDestinyManifest(ctx context.Context, req Destiny2GetDest
 	//	  ]
 	//	}
 	var resp ServerResponse[Manifest]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.GetDestinyManifest",
 		Method:   "GET",
 		PathSpec: "/Destiny2/Manifest/", PathParams: map[string]string{}, QueryParams: url.Values{}}, &resp)
@@ -829,6 +1097,12 @@ func (a API) Destiny2GetDestinyManifest(ctx context.Context, req Destiny2GetDest
 type Destiny2GetClanBannerSourceRequest struct {
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2GetClanBannerSourceRequest) Validate() error {
+	return nil
+}
+
 // Destiny2GetClanBannerSource: Returns the dictionary of values for the Clan Banner
 //
 // URL: /Destiny2/Clan/ClanBannerDictionary/
@@ -848,6 +1122,11 @@ func (a API) Destiny2GetClanBannerSource(ctx context.Context, req Destiny2GetCla
 	//	  ]
 	//	}
 	var resp ServerResponse[ClanBannerSource]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.GetClanBannerSource",
 		Method:   "GET",
 		PathSpec: "/Destiny2/Clan/ClanBannerDictionary/", PathParams: map[string]string{}, QueryParams: url.Values{}}, &resp)
@@ -862,6 +1141,12 @@ type Destiny2AwaInitializeRequestRequest struct {
 	Body AwaPermissionRequested
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2AwaInitializeRequestRequest) Validate() error {
+	return nil
+}
+
 // Destiny2AwaInitializeRequest: Initialize a request to perform an advanced write action.
 //
 // URL: /Destiny2/Awa/Initialize/
@@ -900,6 +1185,11 @@ func (a API) Destiny2AwaInitializeRequest(ctx context.Context, req Destiny2AwaIn
 	//	  ]
 	//	}
 	var resp ServerResponse[AwaInitializeResponse]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.AwaInitializeRequest",
 		Method:   "POST",
 		PathSpec: "/Destiny2/Awa/Initialize/", PathParams: map[string]string{}, QueryParams: url.Values{}, Body: req.Body}, &resp)
@@ -914,6 +1204,12 @@ type Destiny2AwaProvideAuthorizationResultRequest struct {
 	Body AwaUserResponse
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2AwaProvideAuthorizationResultRequest) Validate() error {
+	return nil
+}
+
 // Destiny2AwaProvideAuthorizationResult: Provide the result of the user interaction. Called by the
 // Bungie Destiny App to approve or reject a request.
 //
@@ -944,6 +1240,11 @@ func (a API) Destiny2AwaProvideAuthorizationResult(ctx context.Context, req Dest
 	//	  ]
 	//	}
 	var resp ServerResponse[int32]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.AwaProvideAuthorizationResult",
 		Method:   "POST",
 		PathSpec: "/Destiny2/Awa/AwaProvideAuthorizationResult/", PathParams: map[string]string{}, QueryParams: url.Values{}, Body: req.Body}, &resp)
@@ -958,6 +1259,12 @@ type Destiny2UpdateLoadoutIdentifiersRequest struct {
 	Body LoadoutUpdateActionRequestBody
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2UpdateLoadoutIdentifiersRequest) Validate() error {
+	return nil
+}
+
 // Destiny2UpdateLoadoutIdentifiers: Update the color, icon, and name of a loadout.
 //
 // URL: /Destiny2/Actions/Loadouts/UpdateLoadoutIdentifiers/
@@ -965,6 +1272,8 @@ type Destiny2UpdateLoadoutIdentifiersRequest struct {
 // Operation: Destiny2.UpdateLoadoutIdentifiers
 //
 // Scope: oauth2 [MoveEquipDestinyItems]
+//
+// Throttle: 1s between actions per user
 func (a API) Destiny2UpdateLoadoutIdentifiers(ctx context.Context, req Destiny2UpdateLoadoutIdentifiersRequest) (*ServerResponse[int32], error) {
 	//	{
 	//	  "description": "Update the color, icon, and name of a loadout.",
@@ -999,6 +1308,11 @@ func (a API) Destiny2UpdateLoadoutIdentifiers(ctx context.Context, req Destiny2U
 	//	  }
 	//	}
 	var resp ServerResponse[int32]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.UpdateLoadoutIdentifiers",
 		Method:   "POST",
 		PathSpec: "/Destiny2/Actions/Loadouts/UpdateLoadoutIdentifiers/", PathParams: map[string]string{}, QueryParams: url.Values{}, Body: req.Body}, &resp)
@@ -1012,6 +1326,12 @@ type Destiny2SnapshotLoadoutRequest struct {
 	Body LoadoutUpdateActionRequestBody
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2SnapshotLoadoutRequest) Validate() error {
+	return nil
+}
+
 // Destiny2SnapshotLoadout: Snapshot a loadout with the currently equipped items.
 //
 // URL: /Destiny2/Actions/Loadouts/SnapshotLoadout/
@@ -1019,6 +1339,8 @@ type Destiny2SnapshotLoadoutRequest struct {
 // Operation: Destiny2.SnapshotLoadout
 //
 // Scope: oauth2 [MoveEquipDestinyItems]
+//
+// Throttle: 1s between actions per user
 func (a API) Destiny2SnapshotLoadout(ctx context.Context, req Destiny2SnapshotLoadoutRequest) (*ServerResponse[int32], error) {
 	//	{
 	//	  "description": "Snapshot a loadout with the currently equipped items.",
@@ -1053,6 +1375,11 @@ func (a API) Destiny2SnapshotLoadout(ctx context.Context, req Destiny2SnapshotLo
 	//	  }
 	//	}
 	var resp ServerResponse[int32]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.SnapshotLoadout",
 		Method:   "POST",
 		PathSpec: "/Destiny2/Actions/Loadouts/SnapshotLoadout/", PathParams: map[string]string{}, QueryParams: url.Values{}, Body: req.Body}, &resp)
@@ -1066,6 +1393,12 @@ type Destiny2EquipLoadoutRequest struct {
 	Body LoadoutActionRequestBody
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2EquipLoadoutRequest) Validate() error {
+	return nil
+}
+
 // Destiny2EquipLoadout: Equip a loadout. You must have a valid Destiny Account, and either be in a
 // social space, in orbit, or offline.
 //
@@ -1074,6 +1407,8 @@ type Destiny2EquipLoadoutRequest struct {
 // Operation: Destiny2.EquipLoadout
 //
 // Scope: oauth2 [MoveEquipDestinyItems]
+//
+// Throttle: 1s between actions per user
 func (a API) Destiny2EquipLoadout(ctx context.Context, req Destiny2EquipLoadoutRequest) (*ServerResponse[int32], error) {
 	//	{
 	//	  "description": "Equip a loadout. You must have a valid Destiny Account, and either be in a social space, in orbit, or offline.",
@@ -1108,6 +1443,11 @@ func (a API) Destiny2EquipLoadout(ctx context.Context, req Destiny2EquipLoadoutR
 	//	  }
 	//	}
 	var resp ServerResponse[int32]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.EquipLoadout",
 		Method:   "POST",
 		PathSpec: "/Destiny2/Actions/Loadouts/EquipLoadout/", PathParams: map[string]string{}, QueryParams: url.Values{}, Body: req.Body}, &resp)
@@ -1121,6 +1461,12 @@ type Destiny2ClearLoadoutRequest struct {
 	Body LoadoutActionRequestBody
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2ClearLoadoutRequest) Validate() error {
+	return nil
+}
+
 // Destiny2ClearLoadout: Clear the identifiers and items of a loadout.
 //
 // URL: /Destiny2/Actions/Loadouts/ClearLoadout/
@@ -1128,6 +1474,8 @@ type Destiny2ClearLoadoutRequest struct {
 // Operation: Destiny2.ClearLoadout
 //
 // Scope: oauth2 [MoveEquipDestinyItems]
+//
+// Throttle: 1s between actions per user
 func (a API) Destiny2ClearLoadout(ctx context.Context, req Destiny2ClearLoadoutRequest) (*ServerResponse[int32], error) {
 	//	{
 	//	  "description": "Clear the identifiers and items of a loadout.",
@@ -1162,6 +1510,11 @@ func (a API) Destiny2ClearLoadout(ctx context.Context, req Destiny2ClearLoadoutR
 	//	  }
 	//	}
 	var resp ServerResponse[int32]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.ClearLoadout",
 		Method:   "POST",
 		PathSpec: "/Destiny2/Actions/Loadouts/ClearLoadout/", PathParams: map[string]string{}, QueryParams: url.Values{}, Body: req.Body}, &resp)
@@ -1175,6 +1528,12 @@ type Destiny2TransferItemRequest struct {
 	Body ItemTransferRequestBody
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2TransferItemRequest) Validate() error {
+	return nil
+}
+
 // Destiny2TransferItem: Transfer an item to/from your vault. You must have a valid Destiny account.
 // You must also pass BOTH a reference AND an instance ID if it's an instanced item. itshappening.gif
 //
@@ -1183,6 +1542,8 @@ type Destiny2TransferItemRequest struct {
 // Operation: Destiny2.TransferItem
 //
 // Scope: oauth2 [MoveEquipDestinyItems]
+//
+// Throttle: 0.1s between actions per user
 func (a API) Destiny2TransferItem(ctx context.Context, req Destiny2TransferItemRequest) (*ServerResponse[int32], error) {
 	//	{
 	//	  "description": "Transfer an item to/from your vault. You must have a valid Destiny account. You must also pass BOTH a reference AND an instance ID if it's an instanced item. itshappening.gif",
@@ -1217,6 +1578,11 @@ func (a API) Destiny2TransferItem(ctx context.Context, req Destiny2TransferItemR
 	//	  }
 	//	}
 	var resp ServerResponse[int32]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.TransferItem",
 		Method:   "POST",
 		PathSpec: "/Destiny2/Actions/Items/TransferItem/", PathParams: map[string]string{}, QueryParams: url.Values{}, Body: req.Body}, &resp)
@@ -1231,6 +1597,12 @@ type Destiny2SetQuestTrackedStateRequest struct {
 	Body ItemStateRequestBody
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2SetQuestTrackedStateRequest) Validate() error {
+	return nil
+}
+
 // Destiny2SetQuestTrackedState: Set the Tracking State for an instanced item, if that item is a Quest
 // or Bounty. You must have a valid Destiny Account. Yeah, it's an item.
 //
@@ -1239,6 +1611,8 @@ type Destiny2SetQuestTrackedStateRequest struct {
 // Operation: Destiny2.SetQuestTrackedState
 //
 // Scope: oauth2 [MoveEquipDestinyItems]
+//
+// Throttle: 1s between actions per user
 func (a API) Destiny2SetQuestTrackedState(ctx context.Context, req Destiny2SetQuestTrackedStateRequest) (*ServerResponse[int32], error) {
 	//	{
 	//	  "description": "Set the Tracking State for an instanced item, if that item is a Quest or Bounty. You must have a valid Destiny Account. Yeah, it's an item.",
@@ -1273,6 +1647,11 @@ func (a API) Destiny2SetQuestTrackedState(ctx context.Context, req Destiny2SetQu
 	//	  }
 	//	}
 	var resp ServerResponse[int32]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.SetQuestTrackedState",
 		Method:   "POST",
 		PathSpec: "/Destiny2/Actions/Items/SetTrackedState/", PathParams: map[string]string{}, QueryParams: url.Values{}, Body: req.Body}, &resp)
@@ -1286,6 +1665,12 @@ type Destiny2SetItemLockStateRequest struct {
 	Body ItemStateRequestBody
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2SetItemLockStateRequest) Validate() error {
+	return nil
+}
+
 // Destiny2SetItemLockState: Set the Lock State for an instanced item. You must have a valid Destiny
 // Account.
 //
@@ -1294,6 +1679,8 @@ type Destiny2SetItemLockStateRequest struct {
 // Operation: Destiny2.SetItemLockState
 //
 // Scope: oauth2 [MoveEquipDestinyItems]
+//
+// Throttle: 0.1s between actions per user
 func (a API) Destiny2SetItemLockState(ctx context.Context, req Destiny2SetItemLockStateRequest) (*ServerResponse[int32], error) {
 	//	{
 	//	  "description": "Set the Lock State for an instanced item. You must have a valid Destiny Account.",
@@ -1328,6 +1715,11 @@ func (a API) Destiny2SetItemLockState(ctx context.Context, req Destiny2SetItemLo
 	//	  }
 	//	}
 	var resp ServerResponse[int32]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.SetItemLockState",
 		Method:   "POST",
 		PathSpec: "/Destiny2/Actions/Items/SetLockState/", PathParams: map[string]string{}, QueryParams: url.Values{}, Body: req.Body}, &resp)
@@ -1342,6 +1734,12 @@ type Destiny2PullFromPostmasterRequest struct {
 	Body PostmasterTransferRequestBody
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2PullFromPostmasterRequest) Validate() error {
+	return nil
+}
+
 // Destiny2PullFromPostmaster: Extract an item from the Postmaster, with whatever implications that may
 // entail. You must have a valid Destiny account. You must also pass BOTH a reference AND an instance
 // ID if it's an instanced item.
@@ -1351,6 +1749,8 @@ type Destiny2PullFromPostmasterRequest struct {
 // Operation: Destiny2.PullFromPostmaster
 //
 // Scope: oauth2 [MoveEquipDestinyItems]
+//
+// Throttle: 0.1s between actions per user
 func (a API) Destiny2PullFromPostmaster(ctx context.Context, req Destiny2PullFromPostmasterRequest) (*ServerResponse[int32], error) {
 	//	{
 	//	  "description": "Extract an item from the Postmaster, with whatever implications that may entail. You must have a valid Destiny account. You must also pass BOTH a reference AND an instance ID if it's an instanced item.",
@@ -1385,6 +1785,11 @@ func (a API) Destiny2PullFromPostmaster(ctx context.Context, req Destiny2PullFro
 	//	  }
 	//	}
 	var resp ServerResponse[int32]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.PullFromPostmaster",
 		Method:   "POST",
 		PathSpec: "/Destiny2/Actions/Items/PullFromPostmaster/", PathParams: map[string]string{}, QueryParams: url.Values{}, Body: req.Body}, &resp)
@@ -1399,6 +1804,12 @@ type Destiny2InsertSocketPlugFreeRequest struct {
 	Body InsertPlugsFreeActionRequestBody
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2InsertSocketPlugFreeRequest) Validate() error {
+	return nil
+}
+
 // Destiny2InsertSocketPlugFree: Insert a 'free' plug into an item's socket. This does not require
 // 'Advanced Write Action' authorization and is available to 3rd-party apps, but will only work on
 // 'free and reversible' socket actions (Perks, Armor Mods, Shaders, Ornaments, etc.). You must have a
@@ -1409,6 +1820,8 @@ type Destiny2InsertSocketPlugFreeRequest struct {
 // Operation: Destiny2.InsertSocketPlugFree
 //
 // Scope: oauth2 [MoveEquipDestinyItems]
+//
+// Throttle: 0.5s between actions per user
 func (a API) Destiny2InsertSocketPlugFree(ctx context.Context, req Destiny2InsertSocketPlugFreeRequest) (*ServerResponse[ItemChangeResponse], error) {
 	//	{
 	//	  "description": "Insert a 'free' plug into an item's socket. This does not require 'Advanced Write Action' authorization and is available to 3rd-party apps, but will only work on 'free and reversible' socket actions (Perks, Armor Mods, Shaders, Ornaments, etc.). You must have a valid Destiny Account, and the character must either be in a social space, in orbit, or offline.",
@@ -1445,6 +1858,11 @@ func (a API) Destiny2InsertSocketPlugFree(ctx context.Context, req Destiny2Inser
 	//	  "x-preview": true
 	//	}
 	var resp ServerResponse[ItemChangeResponse]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.InsertSocketPlugFree",
 		Method:   "POST",
 		PathSpec: "/Destiny2/Actions/Items/InsertSocketPlugFree/", PathParams: map[string]string{}, QueryParams: url.Values{}, Body: req.Body}, &resp)
@@ -1458,6 +1876,12 @@ type Destiny2InsertSocketPlugRequest struct {
 	Body InsertPlugsActionRequestBody
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2InsertSocketPlugRequest) Validate() error {
+	return nil
+}
+
 // Destiny2InsertSocketPlug: Insert a plug into a socketed item. I know how it sounds, but I assure you
 // it's much more G-rated than you might be guessing. We haven't decided yet whether this will be able
 // to insert plugs that have side effects, but if we do it will require special scope permission for an
@@ -1470,6 +1894,8 @@ type Destiny2InsertSocketPlugRequest struct {
 // Operation: Destiny2.InsertSocketPlug
 //
 // Scope: oauth2 [AdvancedWriteActions]
+//
+// Throttle: 0.5s between actions per user
 func (a API) Destiny2InsertSocketPlug(ctx context.Context, req Destiny2InsertSocketPlugRequest) (*ServerResponse[ItemChangeResponse], error) {
 	//	{
 	//	  "description": "Insert a plug into a socketed item. I know how it sounds, but I assure you it's much more G-rated than you might be guessing. We haven't decided yet whether this will be able to insert plugs that have side effects, but if we do it will require special scope permission for an application attempting to do so. You must have a valid Destiny Account, and either be in a social space, in orbit, or offline. Request must include proof of permission for 'InsertPlugs' from the account owner.",
@@ -1506,6 +1932,11 @@ func (a API) Destiny2InsertSocketPlug(ctx context.Context, req Destiny2InsertSoc
 	//	  "x-preview": true
 	//	}
 	var resp ServerResponse[ItemChangeResponse]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.InsertSocketPlug",
 		Method:   "POST",
 		PathSpec: "/Destiny2/Actions/Items/InsertSocketPlug/", PathParams: map[string]string{}, QueryParams: url.Values{}, Body: req.Body}, &resp)
@@ -1519,6 +1950,12 @@ type Destiny2EquipItemsRequest struct {
 	Body ItemSetActionRequestBody
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2EquipItemsRequest) Validate() error {
+	return nil
+}
+
 // Destiny2EquipItems: Equip a list of items by itemInstanceIds. You must have a valid Destiny Account,
 // and either be in a social space, in orbit, or offline. Any items not found on your character will be
 // ignored.
@@ -1528,6 +1965,8 @@ type Destiny2EquipItemsRequest struct {
 // Operation: Destiny2.EquipItems
 //
 // Scope: oauth2 [MoveEquipDestinyItems]
+//
+// Throttle: 0.1s between actions per user
 func (a API) Destiny2EquipItems(ctx context.Context, req Destiny2EquipItemsRequest) (*ServerResponse[EquipItemResults], error) {
 	//	{
 	//	  "description": "Equip a list of items by itemInstanceIds. You must have a valid Destiny Account, and either be in a social space, in orbit, or offline. Any items not found on your character will be ignored.",
@@ -1562,6 +2001,11 @@ func (a API) Destiny2EquipItems(ctx context.Context, req Destiny2EquipItemsReque
 	//	  }
 	//	}
 	var resp ServerResponse[EquipItemResults]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.EquipItems",
 		Method:   "POST",
 		PathSpec: "/Destiny2/Actions/Items/EquipItems/", PathParams: map[string]string{}, QueryParams: url.Values{}, Body: req.Body}, &resp)
@@ -1575,6 +2019,12 @@ type Destiny2EquipItemRequest struct {
 	Body ItemActionRequestBody
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2EquipItemRequest) Validate() error {
+	return nil
+}
+
 // Destiny2EquipItem: Equip an item. You must have a valid Destiny Account, and either be in a social
 // space, in orbit, or offline.
 //
@@ -1583,6 +2033,8 @@ type Destiny2EquipItemRequest struct {
 // Operation: Destiny2.EquipItem
 //
 // Scope: oauth2 [MoveEquipDestinyItems]
+//
+// Throttle: 0.1s between actions per user
 func (a API) Destiny2EquipItem(ctx context.Context, req Destiny2EquipItemRequest) (*ServerResponse[int32], error) {
 	//	{
 	//	  "description": "Equip an item. You must have a valid Destiny Account, and either be in a social space, in orbit, or offline.",
@@ -1617,6 +2069,11 @@ func (a API) Destiny2EquipItem(ctx context.Context, req Destiny2EquipItemRequest
 	//	  }
 	//	}
 	var resp ServerResponse[int32]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.EquipItem",
 		Method:   "POST",
 		PathSpec: "/Destiny2/Actions/Items/EquipItem/", PathParams: map[string]string{}, QueryParams: url.Values{}, Body: req.Body}, &resp)
@@ -1627,6 +2084,12 @@ func (a API) Destiny2EquipItem(ctx context.Context, req Destiny2EquipItemRequest
 type AppGetBungieApplicationsRequest struct {
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r AppGetBungieApplicationsRequest) Validate() error {
+	return nil
+}
+
 // AppGetBungieApplications: Get list of applications created by Bungie.
 //
 // URL: /App/FirstParty/
@@ -1646,6 +2109,11 @@ func (a API) AppGetBungieApplications(ctx context.Context, req AppGetBungieAppli
 	//	  ]
 	//	}
 	var resp ServerResponse[[]Application]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "App.GetBungieApplications",
 		Method:   "GET",
 		PathSpec: "/App/FirstParty/", PathParams: map[string]string{}, QueryParams: url.Values{}}, &resp)
@@ -1664,6 +2132,12 @@ type UserSearchByGlobalNamePostRequest struct {
 	Body UserSearchPrefixRequestBody
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r UserSearchByGlobalNamePostRequest) Validate() error {
+	return nil
+}
+
 // UserSearchByGlobalNamePost: Given the prefix of a global display name, returns all users who share
 // that name.
 //
@@ -1706,6 +2180,11 @@ func (a API) UserSearchByGlobalNamePost(ctx context.Context, req UserSearchByGlo
 	//	  ]
 	//	}
 	var resp ServerResponse[UserSearchResponse]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "User.SearchByGlobalNamePost",
 		Method:   "POST",
 		PathSpec: "/User/Search/GlobalName/{page}/", PathParams: map[string]string{
@@ -1714,6 +2193,22 @@ func (a API) UserSearchByGlobalNamePost(ctx context.Context, req UserSearchByGlo
 	return &resp, err
 }
 
+// UserSearchByGlobalNamePostAll returns an iterator over the results of every page of
+// UserSearchByGlobalNamePost, starting at the page in req. See Paginate.
+func (a API) UserSearchByGlobalNamePostAll(ctx context.Context, req UserSearchByGlobalNamePostRequest) func(yield func(UserSearchResponseDetail, error) bool) {
+	return Paginate(ctx, req.Page, func(ctx context.Context, page int32, token string) (Page[UserSearchResponseDetail], error) {
+		req.Page = page
+		resp, err := a.UserSearchByGlobalNamePost(ctx, req)
+		if err != nil {
+			return Page[UserSearchResponseDetail]{}, err
+		}
+		return Page[UserSearchResponseDetail]{
+			Results: resp.Response.SearchResults,
+			HasMore: resp.Response.HasMore,
+		}, nil
+	})
+}
+
 // UserGetSanitizedPlatformDisplayNamesRequest are the request parameters for operation
 // User.GetSanitizedPlatformDisplayNames
 type UserGetSanitizedPlatformDisplayNamesRequest struct {
@@ -1723,6 +2218,15 @@ type UserGetSanitizedPlatformDisplayNamesRequest struct {
 	MembershipID Int64
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r UserGetSanitizedPlatformDisplayNamesRequest) Validate() error {
+	if r.MembershipID == 0 {
+		return invalidRequest("UserGetSanitizedPlatformDisplayNamesRequest", "MembershipID", "required")
+	}
+	return nil
+}
+
 // UserGetSanitizedPlatformDisplayNames: Gets a list of all display names linked to this membership id
 // but sanitized (profanity filtered). Obeys all visibility rules of calling user and is heavily
 // cached.
@@ -1756,6 +2260,11 @@ func (a API) UserGetSanitizedPlatformDisplayNames(ctx context.Context, req UserG
 	//	  ]
 	//	}
 	var resp ServerResponse[map[string]string]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "User.GetSanitizedPlatformDisplayNames",
 		Method:   "GET",
 		PathSpec: "/User/GetSanitizedPlatformDisplayNames/{membershipId}/", PathParams: map[string]string{
@@ -1773,6 +2282,15 @@ type UserGetCredentialTypesForTargetAccountRequest struct {
 	MembershipID Int64
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r UserGetCredentialTypesForTargetAccountRequest) Validate() error {
+	if r.MembershipID == 0 {
+		return invalidRequest("UserGetCredentialTypesForTargetAccountRequest", "MembershipID", "required")
+	}
+	return nil
+}
+
 // UserGetCredentialTypesForTargetAccount: Returns a list of credential types attached to the requested
 // account
 //
@@ -1805,6 +2323,11 @@ func (a API) UserGetCredentialTypesForTargetAccount(ctx context.Context, req Use
 	//	  ]
 	//	}
 	var resp ServerResponse[[]GetCredentialTypesForAccountResponse]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "User.GetCredentialTypesForTargetAccount",
 		Method:   "GET",
 		PathSpec: "/User/GetCredentialTypesForTargetAccount/{membershipId}/", PathParams: map[string]string{
@@ -1821,6 +2344,15 @@ type UserGetBungieNetUserByIdRequest struct {
 	Id Int64
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r UserGetBungieNetUserByIdRequest) Validate() error {
+	if r.Id == 0 {
+		return invalidRequest("UserGetBungieNetUserByIdRequest", "Id", "required")
+	}
+	return nil
+}
+
 // UserGetBungieNetUserById: Loads a bungienet user by membership id.
 //
 // URL: /User/GetBungieNetUserById/{id}/
@@ -1852,6 +2384,11 @@ func (a API) UserGetBungieNetUserById(ctx context.Context, req UserGetBungieNetU
 	//	  ]
 	//	}
 	var resp ServerResponse[GeneralUser]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "User.GetBungieNetUserById",
 		Method:   "GET",
 		PathSpec: "/User/GetBungieNetUserById/{id}/", PathParams: map[string]string{
@@ -1870,6 +2407,15 @@ type TokensGetBungieRewardsForUserRequest struct {
 	MembershipID Int64
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r TokensGetBungieRewardsForUserRequest) Validate() error {
+	if r.MembershipID == 0 {
+		return invalidRequest("TokensGetBungieRewardsForUserRequest", "MembershipID", "required")
+	}
+	return nil
+}
+
 // TokensGetBungieRewardsForUser: Returns the bungie rewards for the targeted user.
 //
 // URL: /Tokens/Rewards/GetRewardsForUser/{membershipId}/
@@ -1910,6 +2456,11 @@ func (a API) TokensGetBungieRewardsForUser(ctx context.Context, req TokensGetBun
 	//	  ]
 	//	}
 	var resp ServerResponse[map[string]BungieRewardDisplay]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Tokens.GetBungieRewardsForUser",
 		Method:   "GET",
 		PathSpec: "/Tokens/Rewards/GetRewardsForUser/{membershipId}/", PathParams: map[string]string{
@@ -1926,6 +2477,15 @@ type SocialRemoveFriendRequestRequest struct {
 	MembershipID string
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r SocialRemoveFriendRequestRequest) Validate() error {
+	if r.MembershipID == "" {
+		return invalidRequest("SocialRemoveFriendRequestRequest", "MembershipID", "required")
+	}
+	return nil
+}
+
 // SocialRemoveFriendRequest: Remove a friend relationship with the target user. The user must be on
 // your outgoing request friend list, though no error will occur if they are not.
 //
@@ -1966,6 +2526,11 @@ func (a API) SocialRemoveFriendRequest(ctx context.Context, req SocialRemoveFrie
 	//	  ]
 	//	}
 	var resp ServerResponse[bool]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Social.RemoveFriendRequest",
 		Method:   "POST",
 		PathSpec: "/Social/Friends/Requests/Remove/{membershipId}/", PathParams: map[string]string{
@@ -1983,6 +2548,15 @@ type SocialDeclineFriendRequestRequest struct {
 	MembershipID string
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r SocialDeclineFriendRequestRequest) Validate() error {
+	if r.MembershipID == "" {
+		return invalidRequest("SocialDeclineFriendRequestRequest", "MembershipID", "required")
+	}
+	return nil
+}
+
 // SocialDeclineFriendRequest: Declines a friend relationship with the target user. The user must be on
 // your incoming friend request list, though no error will occur if they are not.
 //
@@ -2023,6 +2597,11 @@ func (a API) SocialDeclineFriendRequest(ctx context.Context, req SocialDeclineFr
 	//	  ]
 	//	}
 	var resp ServerResponse[bool]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Social.DeclineFriendRequest",
 		Method:   "POST",
 		PathSpec: "/Social/Friends/Requests/Decline/{membershipId}/", PathParams: map[string]string{
@@ -2039,6 +2618,15 @@ type SocialAcceptFriendRequestRequest struct {
 	MembershipID string
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r SocialAcceptFriendRequestRequest) Validate() error {
+	if r.MembershipID == "" {
+		return invalidRequest("SocialAcceptFriendRequestRequest", "MembershipID", "required")
+	}
+	return nil
+}
+
 // SocialAcceptFriendRequest: Accepts a friend relationship with the target user. The user must be on
 // your incoming friend request list, though no error will occur if they are not.
 //
@@ -2079,6 +2667,11 @@ func (a API) SocialAcceptFriendRequest(ctx context.Context, req SocialAcceptFrie
 	//	  ]
 	//	}
 	var resp ServerResponse[bool]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Social.AcceptFriendRequest",
 		Method:   "POST",
 		PathSpec: "/Social/Friends/Requests/Accept/{membershipId}/", PathParams: map[string]string{
@@ -2095,6 +2688,15 @@ type SocialRemoveFriendRequest struct {
 	MembershipID string
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r SocialRemoveFriendRequest) Validate() error {
+	if r.MembershipID == "" {
+		return invalidRequest("SocialRemoveFriendRequest", "MembershipID", "required")
+	}
+	return nil
+}
+
 // SocialRemoveFriend: Remove a friend relationship with the target user. The user must be on your
 // friend list, though no error will occur if they are not.
 //
@@ -2135,6 +2737,11 @@ func (a API) SocialRemoveFriend(ctx context.Context, req SocialRemoveFriendReque
 	//	  ]
 	//	}
 	var resp ServerResponse[bool]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Social.RemoveFriend",
 		Method:   "POST",
 		PathSpec: "/Social/Friends/Remove/{membershipId}/", PathParams: map[string]string{
@@ -2151,6 +2758,15 @@ type SocialIssueFriendRequestRequest struct {
 	MembershipID string
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r SocialIssueFriendRequestRequest) Validate() error {
+	if r.MembershipID == "" {
+		return invalidRequest("SocialIssueFriendRequestRequest", "MembershipID", "required")
+	}
+	return nil
+}
+
 // SocialIssueFriendRequest: Requests a friend relationship with the target user. Any of the target
 // user's linked membership ids are valid inputs.
 //
@@ -2191,6 +2807,11 @@ func (a API) SocialIssueFriendRequest(ctx context.Context, req SocialIssueFriend
 	//	  ]
 	//	}
 	var resp ServerResponse[bool]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Social.IssueFriendRequest",
 		Method:   "POST",
 		PathSpec: "/Social/Friends/Add/{membershipId}/", PathParams: map[string]string{
@@ -2211,6 +2832,15 @@ type GroupV2AddOptionalConversationRequest struct {
 	Body GroupOptionalConversationAddRequestBody
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r GroupV2AddOptionalConversationRequest) Validate() error {
+	if r.GroupID == 0 {
+		return invalidRequest("GroupV2AddOptionalConversationRequest", "GroupID", "required")
+	}
+	return nil
+}
+
 // GroupV2AddOptionalConversation: Add a new optional conversation/chat channel. Requires admin
 // permissions to the group.
 //
@@ -2262,6 +2892,11 @@ func (a API) GroupV2AddOptionalConversation(ctx context.Context, req GroupV2AddO
 	//	  ]
 	//	}
 	var resp ServerResponse[Int64]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "GroupV2.AddOptionalConversation",
 		Method:   "POST",
 		PathSpec: "/GroupV2/{groupId}/OptionalConversations/Add/", PathParams: map[string]string{
@@ -2279,6 +2914,15 @@ type GroupV2GetGroupOptionalConversationsRequest struct {
 	GroupID Int64
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r GroupV2GetGroupOptionalConversationsRequest) Validate() error {
+	if r.GroupID == 0 {
+		return invalidRequest("GroupV2GetGroupOptionalConversationsRequest", "GroupID", "required")
+	}
+	return nil
+}
+
 // GroupV2GetGroupOptionalConversations: Gets a list of available optional conversation channels and
 // their settings.
 //
@@ -2311,6 +2955,11 @@ func (a API) GroupV2GetGroupOptionalConversations(ctx context.Context, req Group
 	//	  ]
 	//	}
 	var resp ServerResponse[[]GroupOptionalConversation]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "GroupV2.GetGroupOptionalConversations",
 		Method:   "GET",
 		PathSpec: "/GroupV2/{groupId}/OptionalConversations/", PathParams: map[string]string{
@@ -2332,6 +2981,15 @@ type GroupV2GetPendingMembershipsRequest struct {
 	GroupID Int64
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r GroupV2GetPendingMembershipsRequest) Validate() error {
+	if r.GroupID == 0 {
+		return invalidRequest("GroupV2GetPendingMembershipsRequest", "GroupID", "required")
+	}
+	return nil
+}
+
 // GroupV2GetPendingMemberships: Get the list of users who are awaiting a decision on their application
 // to join a given group. Modified to include application info.
 //
@@ -2383,6 +3041,11 @@ func (a API) GroupV2GetPendingMemberships(ctx context.Context, req GroupV2GetPen
 	//	  ]
 	//	}
 	var resp ServerResponse[SearchResult[GroupMemberApplication]]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "GroupV2.GetPendingMemberships",
 		Method:   "GET",
 		PathSpec: "/GroupV2/{groupId}/Members/Pending/", PathParams: map[string]string{
@@ -2392,6 +3055,23 @@ func (a API) GroupV2GetPendingMemberships(ctx context.Context, req GroupV2GetPen
 	return &resp, err
 }
 
+// GroupV2GetPendingMembershipsAll returns an iterator over the results of every page of
+// GroupV2GetPendingMemberships, starting at the page in req. See Paginate.
+func (a API) GroupV2GetPendingMembershipsAll(ctx context.Context, req GroupV2GetPendingMembershipsRequest) func(yield func(GroupMemberApplication, error) bool) {
+	return Paginate(ctx, req.Currentpage, func(ctx context.Context, page int32, token string) (Page[GroupMemberApplication], error) {
+		req.Currentpage = page
+		resp, err := a.GroupV2GetPendingMemberships(ctx, req)
+		if err != nil {
+			return Page[GroupMemberApplication]{}, err
+		}
+		return Page[GroupMemberApplication]{
+			Results:           resp.Response.Results,
+			HasMore:           resp.Response.HasMore,
+			ContinuationToken: resp.Response.ReplacementContinuationToken,
+		}, nil
+	})
+}
+
 // GroupV2GetInvitedIndividualsRequest are the request parameters for operation
 // GroupV2.GetInvitedIndividuals
 type GroupV2GetInvitedIndividualsRequest struct {
@@ -2405,6 +3085,15 @@ type GroupV2GetInvitedIndividualsRequest struct {
 	GroupID Int64
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r GroupV2GetInvitedIndividualsRequest) Validate() error {
+	if r.GroupID == 0 {
+		return invalidRequest("GroupV2GetInvitedIndividualsRequest", "GroupID", "required")
+	}
+	return nil
+}
+
 // GroupV2GetInvitedIndividuals: Get the list of users who have been invited into the group.
 //
 // URL: /GroupV2/{groupId}/Members/InvitedIndividuals/
@@ -2455,6 +3144,11 @@ func (a API) GroupV2GetInvitedIndividuals(ctx context.Context, req GroupV2GetInv
 	//	  ]
 	//	}
 	var resp ServerResponse[SearchResult[GroupMemberApplication]]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "GroupV2.GetInvitedIndividuals",
 		Method:   "GET",
 		PathSpec: "/GroupV2/{groupId}/Members/InvitedIndividuals/", PathParams: map[string]string{
@@ -2464,6 +3158,23 @@ func (a API) GroupV2GetInvitedIndividuals(ctx context.Context, req GroupV2GetInv
 	return &resp, err
 }
 
+// GroupV2GetInvitedIndividualsAll returns an iterator over the results of every page of
+// GroupV2GetInvitedIndividuals, starting at the page in req. See Paginate.
+func (a API) GroupV2GetInvitedIndividualsAll(ctx context.Context, req GroupV2GetInvitedIndividualsRequest) func(yield func(GroupMemberApplication, error) bool) {
+	return Paginate(ctx, req.Currentpage, func(ctx context.Context, page int32, token string) (Page[GroupMemberApplication], error) {
+		req.Currentpage = page
+		resp, err := a.GroupV2GetInvitedIndividuals(ctx, req)
+		if err != nil {
+			return Page[GroupMemberApplication]{}, err
+		}
+		return Page[GroupMemberApplication]{
+			Results:           resp.Response.Results,
+			HasMore:           resp.Response.HasMore,
+			ContinuationToken: resp.Response.ReplacementContinuationToken,
+		}, nil
+	})
+}
+
 // GroupV2DenyPendingForListRequest are the request parameters for operation GroupV2.DenyPendingForList
 type GroupV2DenyPendingForListRequest struct {
 
@@ -2475,6 +3186,15 @@ type GroupV2DenyPendingForListRequest struct {
 	Body GroupApplicationListRequestBody
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r GroupV2DenyPendingForListRequest) Validate() error {
+	if r.GroupID == 0 {
+		return invalidRequest("GroupV2DenyPendingForListRequest", "GroupID", "required")
+	}
+	return nil
+}
+
 // GroupV2DenyPendingForList: Deny all of the pending users for the given group that match the
 // passed-in .
 //
@@ -2526,6 +3246,11 @@ func (a API) GroupV2DenyPendingForList(ctx context.Context, req GroupV2DenyPendi
 	//	  ]
 	//	}
 	var resp ServerResponse[[]EntityActionResult]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "GroupV2.DenyPendingForList",
 		Method:   "POST",
 		PathSpec: "/GroupV2/{groupId}/Members/DenyList/", PathParams: map[string]string{
@@ -2545,6 +3270,15 @@ type GroupV2DenyAllPendingRequest struct {
 	Body GroupApplicationRequestBody
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r GroupV2DenyAllPendingRequest) Validate() error {
+	if r.GroupID == 0 {
+		return invalidRequest("GroupV2DenyAllPendingRequest", "GroupID", "required")
+	}
+	return nil
+}
+
 // GroupV2DenyAllPending: Deny all of the pending users for the given group.
 //
 // URL: /GroupV2/{groupId}/Members/DenyAll/
@@ -2595,6 +3329,11 @@ func (a API) GroupV2DenyAllPending(ctx context.Context, req GroupV2DenyAllPendin
 	//	  ]
 	//	}
 	var resp ServerResponse[[]EntityActionResult]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "GroupV2.DenyAllPending",
 		Method:   "POST",
 		PathSpec: "/GroupV2/{groupId}/Members/DenyAll/", PathParams: map[string]string{
@@ -2615,6 +3354,15 @@ type GroupV2ApprovePendingForListRequest struct {
 	Body GroupApplicationListRequestBody
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r GroupV2ApprovePendingForListRequest) Validate() error {
+	if r.GroupID == 0 {
+		return invalidRequest("GroupV2ApprovePendingForListRequest", "GroupID", "required")
+	}
+	return nil
+}
+
 // GroupV2ApprovePendingForList: Approve all of the pending users for the given group.
 //
 // URL: /GroupV2/{groupId}/Members/ApproveList/
@@ -2665,6 +3413,11 @@ func (a API) GroupV2ApprovePendingForList(ctx context.Context, req GroupV2Approv
 	//	  ]
 	//	}
 	var resp ServerResponse[[]EntityActionResult]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "GroupV2.ApprovePendingForList",
 		Method:   "POST",
 		PathSpec: "/GroupV2/{groupId}/Members/ApproveList/", PathParams: map[string]string{
@@ -2684,6 +3437,15 @@ type GroupV2ApproveAllPendingRequest struct {
 	Body GroupApplicationRequestBody
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r GroupV2ApproveAllPendingRequest) Validate() error {
+	if r.GroupID == 0 {
+		return invalidRequest("GroupV2ApproveAllPendingRequest", "GroupID", "required")
+	}
+	return nil
+}
+
 // GroupV2ApproveAllPending: Approve all of the pending users for the given group.
 //
 // URL: /GroupV2/{groupId}/Members/ApproveAll/
@@ -2734,6 +3496,11 @@ func (a API) GroupV2ApproveAllPending(ctx context.Context, req GroupV2ApproveAll
 	//	  ]
 	//	}
 	var resp ServerResponse[[]EntityActionResult]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "GroupV2.ApproveAllPending",
 		Method:   "POST",
 		PathSpec: "/GroupV2/{groupId}/Members/ApproveAll/", PathParams: map[string]string{
@@ -2761,6 +3528,18 @@ type GroupV2GetMembersOfGroupRequest struct {
 	NameSearch string
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r GroupV2GetMembersOfGroupRequest) Validate() error {
+	if r.GroupID == 0 {
+		return invalidRequest("GroupV2GetMembersOfGroupRequest", "GroupID", "required")
+	}
+	if r.MemberType != 0 && !r.MemberType.Valid() {
+		return invalidRequest("GroupV2GetMembersOfGroupRequest", "MemberType", fmt.Sprintf("undefined RuntimeGroupMemberType %d", r.MemberType))
+	}
+	return nil
+}
+
 // GroupV2GetMembersOfGroup: Get the list of members in a given group.
 //
 // URL: /GroupV2/{groupId}/Members/
@@ -2824,6 +3603,11 @@ func (a API) GroupV2GetMembersOfGroup(ctx context.Context, req GroupV2GetMembers
 	//	  ]
 	//	}
 	var resp ServerResponse[SearchResult[GroupMember]]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "GroupV2.GetMembersOfGroup",
 		Method:   "GET",
 		PathSpec: "/GroupV2/{groupId}/Members/", PathParams: map[string]string{
@@ -2836,6 +3620,23 @@ func (a API) GroupV2GetMembersOfGroup(ctx context.Context, req GroupV2GetMembers
 	return &resp, err
 }
 
+// GroupV2GetMembersOfGroupAll returns an iterator over the results of every page of
+// GroupV2GetMembersOfGroup, starting at the page in req. See Paginate.
+func (a API) GroupV2GetMembersOfGroupAll(ctx context.Context, req GroupV2GetMembersOfGroupRequest) func(yield func(GroupMember, error) bool) {
+	return Paginate(ctx, req.Currentpage, func(ctx context.Context, page int32, token string) (Page[GroupMember], error) {
+		req.Currentpage = page
+		resp, err := a.GroupV2GetMembersOfGroup(ctx, req)
+		if err != nil {
+			return Page[GroupMember]{}, err
+		}
+		return Page[GroupMember]{
+			Results:           resp.Response.Results,
+			HasMore:           resp.Response.HasMore,
+			ContinuationToken: resp.Response.ReplacementContinuationToken,
+		}, nil
+	})
+}
+
 // GroupV2GetGroupEditHistoryRequest are the request parameters for operation
 // GroupV2.GetGroupEditHistory
 type GroupV2GetGroupEditHistoryRequest struct {
@@ -2849,6 +3650,15 @@ type GroupV2GetGroupEditHistoryRequest struct {
 	GroupID Int64
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r GroupV2GetGroupEditHistoryRequest) Validate() error {
+	if r.GroupID == 0 {
+		return invalidRequest("GroupV2GetGroupEditHistoryRequest", "GroupID", "required")
+	}
+	return nil
+}
+
 // GroupV2GetGroupEditHistory: Get the list of edits made to a given group. Only accessible to group
 // Admins and above.
 //
@@ -2900,6 +3710,11 @@ func (a API) GroupV2GetGroupEditHistory(ctx context.Context, req GroupV2GetGroup
 	//	  ]
 	//	}
 	var resp ServerResponse[SearchResult[GroupEditHistory]]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "GroupV2.GetGroupEditHistory",
 		Method:   "GET",
 		PathSpec: "/GroupV2/{groupId}/EditHistory/", PathParams: map[string]string{
@@ -2909,6 +3724,23 @@ func (a API) GroupV2GetGroupEditHistory(ctx context.Context, req GroupV2GetGroup
 	return &resp, err
 }
 
+// GroupV2GetGroupEditHistoryAll returns an iterator over the results of every page of
+// GroupV2GetGroupEditHistory, starting at the page in req. See Paginate.
+func (a API) GroupV2GetGroupEditHistoryAll(ctx context.Context, req GroupV2GetGroupEditHistoryRequest) func(yield func(GroupEditHistory, error) bool) {
+	return Paginate(ctx, req.Currentpage, func(ctx context.Context, page int32, token string) (Page[GroupEditHistory], error) {
+		req.Currentpage = page
+		resp, err := a.GroupV2GetGroupEditHistory(ctx, req)
+		if err != nil {
+			return Page[GroupEditHistory]{}, err
+		}
+		return Page[GroupEditHistory]{
+			Results:           resp.Response.Results,
+			HasMore:           resp.Response.HasMore,
+			ContinuationToken: resp.Response.ReplacementContinuationToken,
+		}, nil
+	})
+}
+
 // GroupV2EditFounderOptionsRequest are the request parameters for operation GroupV2.EditFounderOptions
 type GroupV2EditFounderOptionsRequest struct {
 
@@ -2920,6 +3752,15 @@ type GroupV2EditFounderOptionsRequest struct {
 	Body GroupOptionsEditAction
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r GroupV2EditFounderOptionsRequest) Validate() error {
+	if r.GroupID == 0 {
+		return invalidRequest("GroupV2EditFounderOptionsRequest", "GroupID", "required")
+	}
+	return nil
+}
+
 // GroupV2EditFounderOptions: Edit group options only available to a founder. You must have suitable
 // permissions in the group to perform this operation.
 //
@@ -2971,6 +3812,11 @@ func (a API) GroupV2EditFounderOptions(ctx context.Context, req GroupV2EditFound
 	//	  ]
 	//	}
 	var resp ServerResponse[int32]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "GroupV2.EditFounderOptions",
 		Method:   "POST",
 		PathSpec: "/GroupV2/{groupId}/EditFounderOptions/", PathParams: map[string]string{
@@ -2990,6 +3836,15 @@ type GroupV2EditClanBannerRequest struct {
 	Body ClanBanner
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r GroupV2EditClanBannerRequest) Validate() error {
+	if r.GroupID == 0 {
+		return invalidRequest("GroupV2EditClanBannerRequest", "GroupID", "required")
+	}
+	return nil
+}
+
 // GroupV2EditClanBanner: Edit an existing group's clan banner. You must have suitable permissions in
 // the group to perform this operation. All fields are required.
 //
@@ -3041,6 +3896,11 @@ func (a API) GroupV2EditClanBanner(ctx context.Context, req GroupV2EditClanBanne
 	//	  ]
 	//	}
 	var resp ServerResponse[int32]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "GroupV2.EditClanBanner",
 		Method:   "POST",
 		PathSpec: "/GroupV2/{groupId}/EditClanBanner/", PathParams: map[string]string{
@@ -3060,6 +3920,15 @@ type GroupV2EditGroupRequest struct {
 	Body GroupEditAction
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r GroupV2EditGroupRequest) Validate() error {
+	if r.GroupID == 0 {
+		return invalidRequest("GroupV2EditGroupRequest", "GroupID", "required")
+	}
+	return nil
+}
+
 // GroupV2EditGroup: Edit an existing group. You must have suitable permissions in the group to perform
 // this operation. This latest revision will only edit the fields you pass in - pass null for
 // properties you want to leave unaltered.
@@ -3112,6 +3981,11 @@ func (a API) GroupV2EditGroup(ctx context.Context, req GroupV2EditGroupRequest)
 	//	  ]
 	//	}
 	var resp ServerResponse[int32]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "GroupV2.EditGroup",
 		Method:   "POST",
 		PathSpec: "/GroupV2/{groupId}/Edit/", PathParams: map[string]string{
@@ -3133,6 +4007,15 @@ type GroupV2GetBannedMembersOfGroupRequest struct {
 	GroupID Int64
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r GroupV2GetBannedMembersOfGroupRequest) Validate() error {
+	if r.GroupID == 0 {
+		return invalidRequest("GroupV2GetBannedMembersOfGroupRequest", "GroupID", "required")
+	}
+	return nil
+}
+
 // GroupV2GetBannedMembersOfGroup: Get the list of banned members in a given group. Only accessible to
 // group Admins and above. Not applicable to all groups. Check group features.
 //
@@ -3184,6 +4067,11 @@ func (a API) GroupV2GetBannedMembersOfGroup(ctx context.Context, req GroupV2GetB
 	//	  ]
 	//	}
 	var resp ServerResponse[SearchResult[GroupBan]]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "GroupV2.GetBannedMembersOfGroup",
 		Method:   "GET",
 		PathSpec: "/GroupV2/{groupId}/Banned/", PathParams: map[string]string{
@@ -3193,6 +4081,23 @@ func (a API) GroupV2GetBannedMembersOfGroup(ctx context.Context, req GroupV2GetB
 	return &resp, err
 }
 
+// GroupV2GetBannedMembersOfGroupAll returns an iterator over the results of every page of
+// GroupV2GetBannedMembersOfGroup, starting at the page in req. See Paginate.
+func (a API) GroupV2GetBannedMembersOfGroupAll(ctx context.Context, req GroupV2GetBannedMembersOfGroupRequest) func(yield func(GroupBan, error) bool) {
+	return Paginate(ctx, req.Currentpage, func(ctx context.Context, page int32, token string) (Page[GroupBan], error) {
+		req.Currentpage = page
+		resp, err := a.GroupV2GetBannedMembersOfGroup(ctx, req)
+		if err != nil {
+			return Page[GroupBan]{}, err
+		}
+		return Page[GroupBan]{
+			Results:           resp.Response.Results,
+			HasMore:           resp.Response.HasMore,
+			ContinuationToken: resp.Response.ReplacementContinuationToken,
+		}, nil
+	})
+}
+
 // GroupV2GetAdminsAndFounderOfGroupRequest are the request parameters for operation
 // GroupV2.GetAdminsAndFounderOfGroup
 type GroupV2GetAdminsAndFounderOfGroupRequest struct {
@@ -3206,6 +4111,15 @@ type GroupV2GetAdminsAndFounderOfGroupRequest struct {
 	GroupID Int64
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r GroupV2GetAdminsAndFounderOfGroupRequest) Validate() error {
+	if r.GroupID == 0 {
+		return invalidRequest("GroupV2GetAdminsAndFounderOfGroupRequest", "GroupID", "required")
+	}
+	return nil
+}
+
 // GroupV2GetAdminsAndFounderOfGroup: Get the list of members in a given group who are of admin level
 // or higher.
 //
@@ -3248,6 +4162,11 @@ func (a API) GroupV2GetAdminsAndFounderOfGroup(ctx context.Context, req GroupV2G
 	//	  ]
 	//	}
 	var resp ServerResponse[SearchResult[GroupMember]]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "GroupV2.GetAdminsAndFounderOfGroup",
 		Method:   "GET",
 		PathSpec: "/GroupV2/{groupId}/AdminsAndFounder/", PathParams: map[string]string{
@@ -3257,6 +4176,23 @@ func (a API) GroupV2GetAdminsAndFounderOfGroup(ctx context.Context, req GroupV2G
 	return &resp, err
 }
 
+// GroupV2GetAdminsAndFounderOfGroupAll returns an iterator over the results of every page of
+// GroupV2GetAdminsAndFounderOfGroup, starting at the page in req. See Paginate.
+func (a API) GroupV2GetAdminsAndFounderOfGroupAll(ctx context.Context, req GroupV2GetAdminsAndFounderOfGroupRequest) func(yield func(GroupMember, error) bool) {
+	return Paginate(ctx, req.Currentpage, func(ctx context.Context, page int32, token string) (Page[GroupMember], error) {
+		req.Currentpage = page
+		resp, err := a.GroupV2GetAdminsAndFounderOfGroup(ctx, req)
+		if err != nil {
+			return Page[GroupMember]{}, err
+		}
+		return Page[GroupMember]{
+			Results:           resp.Response.Results,
+			HasMore:           resp.Response.HasMore,
+			ContinuationToken: resp.Response.ReplacementContinuationToken,
+		}, nil
+	})
+}
+
 // GroupV2GetGroupRequest are the request parameters for operation GroupV2.GetGroup
 type GroupV2GetGroupRequest struct {
 
@@ -3265,6 +4201,15 @@ type GroupV2GetGroupRequest struct {
 	GroupID Int64
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r GroupV2GetGroupRequest) Validate() error {
+	if r.GroupID == 0 {
+		return invalidRequest("GroupV2GetGroupRequest", "GroupID", "required")
+	}
+	return nil
+}
+
 // GroupV2GetGroup: Get information about a specific group of the given ID.
 //
 // URL: /GroupV2/{groupId}/
@@ -3296,6 +4241,11 @@ func (a API) GroupV2GetGroup(ctx context.Context, req GroupV2GetGroupRequest) (*
 	//	  ]
 	//	}
 	var resp ServerResponse[GroupResponse]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "GroupV2.GetGroup",
 		Method:   "GET",
 		PathSpec: "/GroupV2/{groupId}/", PathParams: map[string]string{
@@ -3313,6 +4263,18 @@ type GroupV2GetUserClanInviteSettingRequest struct {
 	MType BungieMembershipType
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r GroupV2GetUserClanInviteSettingRequest) Validate() error {
+	if r.MType == BungieMembershipType_None {
+		return invalidRequest("GroupV2GetUserClanInviteSettingRequest", "MType", "required")
+	}
+	if !r.MType.Valid() {
+		return invalidRequest("GroupV2GetUserClanInviteSettingRequest", "MType", fmt.Sprintf("undefined BungieMembershipType %d", r.MType))
+	}
+	return nil
+}
+
 // GroupV2GetUserClanInviteSetting: Gets the state of the user's clan invite preferences for a
 // particular membership type - true if they wish to be invited to clans, false otherwise.
 //
@@ -3359,6 +4321,11 @@ func (a API) GroupV2GetUserClanInviteSetting(ctx context.Context, req GroupV2Get
 	//	  ]
 	//	}
 	var resp ServerResponse[bool]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "GroupV2.GetUserClanInviteSetting",
 		Method:   "GET",
 		PathSpec: "/GroupV2/GetUserClanInviteSetting/{mType}/", PathParams: map[string]string{
@@ -3375,6 +4342,15 @@ type ForumGetPollRequest struct {
 	TopicID Int64
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r ForumGetPollRequest) Validate() error {
+	if r.TopicID == 0 {
+		return invalidRequest("ForumGetPollRequest", "TopicID", "required")
+	}
+	return nil
+}
+
 // ForumGetPoll: Gets the specified forum poll.
 //
 // URL: /Forum/Poll/{topicId}/
@@ -3406,6 +4382,11 @@ func (a API) ForumGetPoll(ctx context.Context, req ForumGetPollRequest) (*Server
 	//	  ]
 	//	}
 	var resp ServerResponse[PostSearchResponse]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Forum.GetPoll",
 		Method:   "GET",
 		PathSpec: "/Forum/Poll/{topicId}/", PathParams: map[string]string{
@@ -3421,6 +4402,15 @@ type ForumGetTopicForContentRequest struct {
 	ContentID Int64
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r ForumGetTopicForContentRequest) Validate() error {
+	if r.ContentID == 0 {
+		return invalidRequest("ForumGetTopicForContentRequest", "ContentID", "required")
+	}
+	return nil
+}
+
 // ForumGetTopicForContent: Gets the post Id for the given content item's comments, if it exists.
 //
 // URL: /Forum/GetTopicForContent/{contentId}/
@@ -3451,6 +4441,11 @@ func (a API) ForumGetTopicForContent(ctx context.Context, req ForumGetTopicForCo
 	//	  ]
 	//	}
 	var resp ServerResponse[Int64]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Forum.GetTopicForContent",
 		Method:   "GET",
 		PathSpec: "/Forum/GetTopicForContent/{contentId}/", PathParams: map[string]string{
@@ -3470,6 +4465,15 @@ type ForumGetPostAndParentAwaitingApprovalRequest struct {
 	Showbanned string
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r ForumGetPostAndParentAwaitingApprovalRequest) Validate() error {
+	if r.ChildPostID == 0 {
+		return invalidRequest("ForumGetPostAndParentAwaitingApprovalRequest", "ChildPostID", "required")
+	}
+	return nil
+}
+
 // ForumGetPostAndParentAwaitingApproval: Returns the post specified and its immediate parent of posts
 // that are awaiting approval.
 //
@@ -3509,6 +4513,11 @@ func (a API) ForumGetPostAndParentAwaitingApproval(ctx context.Context, req Foru
 	//	  ]
 	//	}
 	var resp ServerResponse[PostSearchResponse]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Forum.GetPostAndParentAwaitingApproval",
 		Method:   "GET",
 		PathSpec: "/Forum/GetPostAndParentAwaitingApproval/{childPostId}/", PathParams: map[string]string{
@@ -3529,6 +4538,15 @@ type ForumGetPostAndParentRequest struct {
 	Showbanned string
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r ForumGetPostAndParentRequest) Validate() error {
+	if r.ChildPostID == 0 {
+		return invalidRequest("ForumGetPostAndParentRequest", "ChildPostID", "required")
+	}
+	return nil
+}
+
 // ForumGetPostAndParent: Returns the post specified and its immediate parent.
 //
 // URL: /Forum/GetPostAndParent/{childPostId}/
@@ -3567,6 +4585,11 @@ func (a API) ForumGetPostAndParent(ctx context.Context, req ForumGetPostAndParen
 	//	  ]
 	//	}
 	var resp ServerResponse[PostSearchResponse]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Forum.GetPostAndParent",
 		Method:   "GET",
 		PathSpec: "/Forum/GetPostAndParent/{childPostId}/", PathParams: map[string]string{
@@ -3586,6 +4609,15 @@ type FireteamGetActivePrivateClanFireteamCountRequest struct {
 	GroupID Int64
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r FireteamGetActivePrivateClanFireteamCountRequest) Validate() error {
+	if r.GroupID == 0 {
+		return invalidRequest("FireteamGetActivePrivateClanFireteamCountRequest", "GroupID", "required")
+	}
+	return nil
+}
+
 // FireteamGetActivePrivateClanFireteamCount: Gets a count of all active non-public fireteams for the
 // specified clan. Maximum value returned is 25.
 //
@@ -3627,6 +4659,11 @@ func (a API) FireteamGetActivePrivateClanFireteamCount(ctx context.Context, req
 	//	  ]
 	//	}
 	var resp ServerResponse[int32]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Fireteam.GetActivePrivateClanFireteamCount",
 		Method:   "GET",
 		PathSpec: "/Fireteam/Clan/{groupId}/ActiveCount/", PathParams: map[string]string{
@@ -3647,6 +4684,15 @@ type Destiny2ReportOffensivePostGameCarnageReportPlayerRequest struct {
 	Body ReportOffensePgcrRequestBody
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2ReportOffensivePostGameCarnageReportPlayerRequest) Validate() error {
+	if r.ActivityID == 0 {
+		return invalidRequest("Destiny2ReportOffensivePostGameCarnageReportPlayerRequest", "ActivityID", "required")
+	}
+	return nil
+}
+
 // Destiny2ReportOffensivePostGameCarnageReportPlayer: Report a player that you met in an activity that
 // was engaging in ToS-violating activities. Both you and the offending player must have played in the
 // activityId passed in. Please use this judiciously and only when you have strong suspicions of
@@ -3700,6 +4746,11 @@ func (a API) Destiny2ReportOffensivePostGameCarnageReportPlayer(ctx context.Cont
 	//	  ]
 	//	}
 	var resp ServerResponse[int32]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.ReportOffensivePostGameCarnageReportPlayer",
 		Method:   "POST",
 		PathSpec: "/Destiny2/Stats/PostGameCarnageReport/{activityId}/Report/", PathParams: map[string]string{
@@ -3717,6 +4768,15 @@ type Destiny2GetPostGameCarnageReportRequest struct {
 	ActivityID Int64
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2GetPostGameCarnageReportRequest) Validate() error {
+	if r.ActivityID == 0 {
+		return invalidRequest("Destiny2GetPostGameCarnageReportRequest", "ActivityID", "required")
+	}
+	return nil
+}
+
 // Destiny2GetPostGameCarnageReport: Gets the available post game carnage report for the activity ID.
 //
 // URL: /Destiny2/Stats/PostGameCarnageReport/{activityId}/
@@ -3748,6 +4808,11 @@ func (a API) Destiny2GetPostGameCarnageReport(ctx context.Context, req Destiny2G
 	//	  ]
 	//	}
 	var resp ServerResponse[PostGameCarnageReportData]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.GetPostGameCarnageReport",
 		Method:   "GET",
 		PathSpec: "/Destiny2/Stats/PostGameCarnageReport/{activityId}/", PathParams: map[string]string{
@@ -3775,6 +4840,15 @@ type Destiny2GetClanLeaderboardsRequest struct {
 	Statid string
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2GetClanLeaderboardsRequest) Validate() error {
+	if r.GroupID == 0 {
+		return invalidRequest("Destiny2GetClanLeaderboardsRequest", "GroupID", "required")
+	}
+	return nil
+}
+
 // Destiny2GetClanLeaderboards: Gets leaderboards with the signed in user's friends and the supplied
 // destinyMembershipId as the focus. PREVIEW: This endpoint is still in beta, and may experience rough
 // edges. The schema is in final form, but there may be bugs that prevent desirable operation.
@@ -3835,6 +4909,11 @@ func (a API) Destiny2GetClanLeaderboards(ctx context.Context, req Destiny2GetCla
 	//	  "x-preview": true
 	//	}
 	var resp ServerResponse[map[string]map[string]Leaderboard]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.GetClanLeaderboards",
 		Method:   "GET",
 		PathSpec: "/Destiny2/Stats/Leaderboards/Clans/{groupId}/", PathParams: map[string]string{
@@ -3860,6 +4939,15 @@ type Destiny2GetClanAggregateStatsRequest struct {
 	Modes string
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2GetClanAggregateStatsRequest) Validate() error {
+	if r.GroupID == 0 {
+		return invalidRequest("Destiny2GetClanAggregateStatsRequest", "GroupID", "required")
+	}
+	return nil
+}
+
 // Destiny2GetClanAggregateStats: Gets aggregated stats for a clan using the same categories as the
 // clan leaderboards. PREVIEW: This endpoint is still in beta, and may experience rough edges. The
 // schema is in final form, but there may be bugs that prevent desirable operation.
@@ -3903,6 +4991,11 @@ func (a API) Destiny2GetClanAggregateStats(ctx context.Context, req Destiny2GetC
 	//	  "x-preview": true
 	//	}
 	var resp ServerResponse[[]ClanAggregateStat]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.GetClanAggregateStats",
 		Method:   "GET",
 		PathSpec: "/Destiny2/Stats/AggregateClanStats/{groupId}/", PathParams: map[string]string{
@@ -3926,6 +5019,18 @@ type Destiny2SearchDestinyPlayerByBungieNameRequest struct {
 	Body ExactSearchRequestBody
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2SearchDestinyPlayerByBungieNameRequest) Validate() error {
+	if r.MembershipType == BungieMembershipType_None {
+		return invalidRequest("Destiny2SearchDestinyPlayerByBungieNameRequest", "MembershipType", "required")
+	}
+	if !r.MembershipType.Valid() {
+		return invalidRequest("Destiny2SearchDestinyPlayerByBungieNameRequest", "MembershipType", fmt.Sprintf("undefined BungieMembershipType %d", r.MembershipType))
+	}
+	return nil
+}
+
 // Destiny2SearchDestinyPlayerByBungieName: Returns a list of Destiny memberships given a global Bungie
 // Display Name. This method will hide overridden memberships due to cross save.
 //
@@ -3973,6 +5078,11 @@ func (a API) Destiny2SearchDestinyPlayerByBungieName(ctx context.Context, req De
 	//	  ]
 	//	}
 	var resp ServerResponse[[]UserInfoCard]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.SearchDestinyPlayerByBungieName",
 		Method:   "POST",
 		PathSpec: "/Destiny2/SearchDestinyPlayerByBungieName/{membershipType}/", PathParams: map[string]string{
@@ -3990,6 +5100,15 @@ type Destiny2GetPublicMilestoneContentRequest struct {
 	MilestoneHash uint32
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2GetPublicMilestoneContentRequest) Validate() error {
+	if r.MilestoneHash == 0 {
+		return invalidRequest("Destiny2GetPublicMilestoneContentRequest", "MilestoneHash", "required")
+	}
+	return nil
+}
+
 // Destiny2GetPublicMilestoneContent: Gets custom localized content for the milestone of the given
 // hash, if it exists.
 //
@@ -4022,6 +5141,11 @@ func (a API) Destiny2GetPublicMilestoneContent(ctx context.Context, req Destiny2
 	//	  ]
 	//	}
 	var resp ServerResponse[MilestoneContent]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.GetPublicMilestoneContent",
 		Method:   "GET",
 		PathSpec: "/Destiny2/Milestones/{milestoneHash}/Content/", PathParams: map[string]string{
@@ -4039,6 +5163,15 @@ type Destiny2GetClanWeeklyRewardStateRequest struct {
 	GroupID Int64
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2GetClanWeeklyRewardStateRequest) Validate() error {
+	if r.GroupID == 0 {
+		return invalidRequest("Destiny2GetClanWeeklyRewardStateRequest", "GroupID", "required")
+	}
+	return nil
+}
+
 // Destiny2GetClanWeeklyRewardState: Returns information on the weekly clan rewards and if the clan has
 // earned them or not. Note that this will always report rewards as not redeemed.
 //
@@ -4071,6 +5204,11 @@ func (a API) Destiny2GetClanWeeklyRewardState(ctx context.Context, req Destiny2G
 	//	  ]
 	//	}
 	var resp ServerResponse[Milestone]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.GetClanWeeklyRewardState",
 		Method:   "GET",
 		PathSpec: "/Destiny2/Clan/{groupId}/WeeklyRewardState/", PathParams: map[string]string{
@@ -4087,6 +5225,15 @@ type Destiny2AwaGetActionTokenRequest struct {
 	CorrelationID string
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2AwaGetActionTokenRequest) Validate() error {
+	if r.CorrelationID == "" {
+		return invalidRequest("Destiny2AwaGetActionTokenRequest", "CorrelationID", "required")
+	}
+	return nil
+}
+
 // Destiny2AwaGetActionToken: Returns the action token if user approves the request.
 //
 // URL: /Destiny2/Awa/GetActionToken/{correlationId}/
@@ -4126,6 +5273,11 @@ func (a API) Destiny2AwaGetActionToken(ctx context.Context, req Destiny2AwaGetAc
 	//	  ]
 	//	}
 	var resp ServerResponse[AwaAuthorizationResult]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.AwaGetActionToken",
 		Method:   "GET",
 		PathSpec: "/Destiny2/Awa/GetActionToken/{correlationId}/", PathParams: map[string]string{
@@ -4160,6 +5312,15 @@ type ContentSearchContentWithTextRequest struct {
 	Tag string
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r ContentSearchContentWithTextRequest) Validate() error {
+	if r.Locale == "" {
+		return invalidRequest("ContentSearchContentWithTextRequest", "Locale", "required")
+	}
+	return nil
+}
+
 // ContentSearchContentWithText: Gets content based on querystring information passed in. Provides
 // basic search and text search capabilities.
 //
@@ -4239,6 +5400,11 @@ func (a API) ContentSearchContentWithText(ctx context.Context, req ContentSearch
 	//	  ]
 	//	}
 	var resp ServerResponse[SearchResult[ContentItemPublicContract]]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Content.SearchContentWithText",
 		Method:   "GET",
 		PathSpec: "/Content/Search/{locale}/", PathParams: map[string]string{
@@ -4254,6 +5420,23 @@ func (a API) ContentSearchContentWithText(ctx context.Context, req ContentSearch
 	return &resp, err
 }
 
+// ContentSearchContentWithTextAll returns an iterator over the results of every page of
+// ContentSearchContentWithText, starting at the page in req. See Paginate.
+func (a API) ContentSearchContentWithTextAll(ctx context.Context, req ContentSearchContentWithTextRequest) func(yield func(ContentItemPublicContract, error) bool) {
+	return Paginate(ctx, req.Currentpage, func(ctx context.Context, page int32, token string) (Page[ContentItemPublicContract], error) {
+		req.Currentpage = page
+		resp, err := a.ContentSearchContentWithText(ctx, req)
+		if err != nil {
+			return Page[ContentItemPublicContract]{}, err
+		}
+		return Page[ContentItemPublicContract]{
+			Results:           resp.Response.Results,
+			HasMore:           resp.Response.HasMore,
+			ContinuationToken: resp.Response.ReplacementContinuationToken,
+		}, nil
+	})
+}
+
 // ContentRssNewsArticlesRequest are the request parameters for operation Content.RssNewsArticles
 type ContentRssNewsArticlesRequest struct {
 
@@ -4268,6 +5451,15 @@ type ContentRssNewsArticlesRequest struct {
 	PageToken string
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r ContentRssNewsArticlesRequest) Validate() error {
+	if r.PageToken == "" {
+		return invalidRequest("ContentRssNewsArticlesRequest", "PageToken", "required")
+	}
+	return nil
+}
+
 // ContentRssNewsArticles: Returns a JSON string response that is the RSS feed for news articles.
 //
 // URL: /Content/Rss/NewsArticles/{pageToken}/
@@ -4314,6 +5506,11 @@ func (a API) ContentRssNewsArticles(ctx context.Context, req ContentRssNewsArtic
 	//	  ]
 	//	}
 	var resp ServerResponse[NewsArticleRssResponse]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Content.RssNewsArticles",
 		Method:   "GET",
 		PathSpec: "/Content/Rss/NewsArticles/{pageToken}/", PathParams: map[string]string{
@@ -4332,6 +5529,15 @@ type ContentGetContentTypeRequest struct {
 	Type string
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r ContentGetContentTypeRequest) Validate() error {
+	if r.Type == "" {
+		return invalidRequest("ContentGetContentTypeRequest", "Type", "required")
+	}
+	return nil
+}
+
 // ContentGetContentType: Gets an object describing a particular variant of content.
 //
 // URL: /Content/GetContentType/{type}/
@@ -4361,6 +5567,11 @@ func (a API) ContentGetContentType(ctx context.Context, req ContentGetContentTyp
 	//	  ]
 	//	}
 	var resp ServerResponse[ContentTypeDescription]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Content.GetContentType",
 		Method:   "GET",
 		PathSpec: "/Content/GetContentType/{type}/", PathParams: map[string]string{
@@ -4383,6 +5594,12 @@ type AppGetApplicationApiUsageRequest struct {
 	Start Timestamp
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r AppGetApplicationApiUsageRequest) Validate() error {
+	return nil
+}
+
 // AppGetApplicationApiUsage: Get API usage by application for time frame specified. You can go as far
 // back as 30 days ago, and can ask for up to a 48 hour window of time in a single request. You must be
 // authenticated with at least the ReadUserData permission to access this endpoint.
@@ -4443,6 +5660,11 @@ func (a API) AppGetApplicationApiUsage(ctx context.Context, req AppGetApplicatio
 	//	  ]
 	//	}
 	var resp ServerResponse[ApiUsage]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "App.GetApplicationApiUsage",
 		Method:   "GET",
 		PathSpec: "/App/ApiUsage/{applicationId}/", PathParams: map[string]string{
@@ -4467,6 +5689,15 @@ type UserSearchByGlobalNamePrefixRequest struct {
 	Page int32
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r UserSearchByGlobalNamePrefixRequest) Validate() error {
+	if r.DisplayNamePrefix == "" {
+		return invalidRequest("UserSearchByGlobalNamePrefixRequest", "DisplayNamePrefix", "required")
+	}
+	return nil
+}
+
 // UserSearchByGlobalNamePrefix: [OBSOLETE] Do not use this to search users, use SearchByGlobalNamePost
 // instead.
 //
@@ -4508,6 +5739,11 @@ func (a API) UserSearchByGlobalNamePrefix(ctx context.Context, req UserSearchByG
 	//	  ]
 	//	}
 	var resp ServerResponse[UserSearchResponse]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "User.SearchByGlobalNamePrefix",
 		Method:   "GET",
 		PathSpec: "/User/Search/Prefix/{displayNamePrefix}/{page}/", PathParams: map[string]string{
@@ -4517,6 +5753,22 @@ func (a API) UserSearchByGlobalNamePrefix(ctx context.Context, req UserSearchByG
 	return &resp, err
 }
 
+// UserSearchByGlobalNamePrefixAll returns an iterator over the results of every page of
+// UserSearchByGlobalNamePrefix, starting at the page in req. See Paginate.
+func (a API) UserSearchByGlobalNamePrefixAll(ctx context.Context, req UserSearchByGlobalNamePrefixRequest) func(yield func(UserSearchResponseDetail, error) bool) {
+	return Paginate(ctx, req.Page, func(ctx context.Context, page int32, token string) (Page[UserSearchResponseDetail], error) {
+		req.Page = page
+		resp, err := a.UserSearchByGlobalNamePrefix(ctx, req)
+		if err != nil {
+			return Page[UserSearchResponseDetail]{}, err
+		}
+		return Page[UserSearchResponseDetail]{
+			Results: resp.Response.SearchResults,
+			HasMore: resp.Response.HasMore,
+		}, nil
+	})
+}
+
 // UserGetMembershipDataByIdRequest are the request parameters for operation User.GetMembershipDataById
 type UserGetMembershipDataByIdRequest struct {
 
@@ -4529,6 +5781,21 @@ type UserGetMembershipDataByIdRequest struct {
 	MembershipType BungieMembershipType
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r UserGetMembershipDataByIdRequest) Validate() error {
+	if r.MembershipID == 0 {
+		return invalidRequest("UserGetMembershipDataByIdRequest", "MembershipID", "required")
+	}
+	if r.MembershipType == BungieMembershipType_None {
+		return invalidRequest("UserGetMembershipDataByIdRequest", "MembershipType", "required")
+	}
+	if !r.MembershipType.Valid() {
+		return invalidRequest("UserGetMembershipDataByIdRequest", "MembershipType", fmt.Sprintf("undefined BungieMembershipType %d", r.MembershipType))
+	}
+	return nil
+}
+
 // UserGetMembershipDataById: Returns a list of accounts associated with the supplied membership ID and
 // membership type. This will include all linked accounts (even when hidden) if supplied credentials
 // permit it.
@@ -4577,6 +5844,11 @@ func (a API) UserGetMembershipDataById(ctx context.Context, req UserGetMembershi
 	//	  ]
 	//	}
 	var resp ServerResponse[UserMembershipData]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "User.GetMembershipDataById",
 		Method:   "GET",
 		PathSpec: "/User/GetMembershipsById/{membershipId}/{membershipType}/", PathParams: map[string]string{
@@ -4599,6 +5871,21 @@ type UserGetMembershipFromHardLinkedCredentialRequest struct {
 	CrType BungieCredentialType
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r UserGetMembershipFromHardLinkedCredentialRequest) Validate() error {
+	if r.Credential == "" {
+		return invalidRequest("UserGetMembershipFromHardLinkedCredentialRequest", "Credential", "required")
+	}
+	if r.CrType == BungieCredentialType_None {
+		return invalidRequest("UserGetMembershipFromHardLinkedCredentialRequest", "CrType", "required")
+	}
+	if !r.CrType.Valid() {
+		return invalidRequest("UserGetMembershipFromHardLinkedCredentialRequest", "CrType", fmt.Sprintf("undefined BungieCredentialType %d", r.CrType))
+	}
+	return nil
+}
+
 // UserGetMembershipFromHardLinkedCredential: Gets any hard linked membership given a credential. Only
 // works for credentials that are public (just SteamID64 right now). Cross Save aware.
 //
@@ -4645,6 +5932,11 @@ func (a API) UserGetMembershipFromHardLinkedCredential(ctx context.Context, req
 	//	  ]
 	//	}
 	var resp ServerResponse[HardLinkedUserMembership]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "User.GetMembershipFromHardLinkedCredential",
 		Method:   "GET",
 		PathSpec: "/User/GetMembershipFromHardLinkedCredential/{crType}/{credential}/", PathParams: map[string]string{
@@ -4667,6 +5959,18 @@ type TrendingGetTrendingEntryDetailRequest struct {
 	TrendingEntryType TrendingEntryType
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r TrendingGetTrendingEntryDetailRequest) Validate() error {
+	if r.Identifier == "" {
+		return invalidRequest("TrendingGetTrendingEntryDetailRequest", "Identifier", "required")
+	}
+	if !r.TrendingEntryType.Valid() {
+		return invalidRequest("TrendingGetTrendingEntryDetailRequest", "TrendingEntryType", fmt.Sprintf("undefined TrendingEntryType %d", r.TrendingEntryType))
+	}
+	return nil
+}
+
 // TrendingGetTrendingEntryDetail: Returns the detailed results for a specific trending entry. Note
 // that trending entries are uniquely identified by a combination of *both* the TrendingEntryType *and*
 // the identifier: the identifier alone is not guaranteed to be globally unique.
@@ -4714,6 +6018,11 @@ func (a API) TrendingGetTrendingEntryDetail(ctx context.Context, req TrendingGet
 	//	  ]
 	//	}
 	var resp ServerResponse[TrendingDetail]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Trending.GetTrendingEntryDetail",
 		Method:   "GET",
 		PathSpec: "/Trending/Details/{trendingEntryType}/{identifier}/", PathParams: map[string]string{
@@ -4736,6 +6045,15 @@ type TrendingGetTrendingCategoryRequest struct {
 	PageNumber int32
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r TrendingGetTrendingCategoryRequest) Validate() error {
+	if r.CategoryID == "" {
+		return invalidRequest("TrendingGetTrendingCategoryRequest", "CategoryID", "required")
+	}
+	return nil
+}
+
 // TrendingGetTrendingCategory: Returns paginated lists of trending items for a category.
 //
 // URL: /Trending/Categories/{categoryId}/{pageNumber}/
@@ -4776,6 +6094,11 @@ func (a API) TrendingGetTrendingCategory(ctx context.Context, req TrendingGetTre
 	//	  ]
 	//	}
 	var resp ServerResponse[SearchResult[TrendingEntry]]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Trending.GetTrendingCategory",
 		Method:   "GET",
 		PathSpec: "/Trending/Categories/{categoryId}/{pageNumber}/", PathParams: map[string]string{
@@ -4785,6 +6108,23 @@ func (a API) TrendingGetTrendingCategory(ctx context.Context, req TrendingGetTre
 	return &resp, err
 }
 
+// TrendingGetTrendingCategoryAll returns an iterator over the results of every page of
+// TrendingGetTrendingCategory, starting at the page in req. See Paginate.
+func (a API) TrendingGetTrendingCategoryAll(ctx context.Context, req TrendingGetTrendingCategoryRequest) func(yield func(TrendingEntry, error) bool) {
+	return Paginate(ctx, req.PageNumber, func(ctx context.Context, page int32, token string) (Page[TrendingEntry], error) {
+		req.PageNumber = page
+		resp, err := a.TrendingGetTrendingCategory(ctx, req)
+		if err != nil {
+			return Page[TrendingEntry]{}, err
+		}
+		return Page[TrendingEntry]{
+			Results:           resp.Response.Results,
+			HasMore:           resp.Response.HasMore,
+			ContinuationToken: resp.Response.ReplacementContinuationToken,
+		}, nil
+	})
+}
+
 // TokensGetBungieRewardsForPlatformUserRequest are the request parameters for operation
 // Tokens.GetBungieRewardsForPlatformUser
 type TokensGetBungieRewardsForPlatformUserRequest struct {
@@ -4799,6 +6139,21 @@ type TokensGetBungieRewardsForPlatformUserRequest struct {
 	MembershipType BungieMembershipType
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r TokensGetBungieRewardsForPlatformUserRequest) Validate() error {
+	if r.MembershipID == 0 {
+		return invalidRequest("TokensGetBungieRewardsForPlatformUserRequest", "MembershipID", "required")
+	}
+	if r.MembershipType == BungieMembershipType_None {
+		return invalidRequest("TokensGetBungieRewardsForPlatformUserRequest", "MembershipType", "required")
+	}
+	if !r.MembershipType.Valid() {
+		return invalidRequest("TokensGetBungieRewardsForPlatformUserRequest", "MembershipType", fmt.Sprintf("undefined BungieMembershipType %d", r.MembershipType))
+	}
+	return nil
+}
+
 // TokensGetBungieRewardsForPlatformUser: Returns the bungie rewards for the targeted user when a
 // platform membership Id and Type are used.
 //
@@ -4855,6 +6210,11 @@ func (a API) TokensGetBungieRewardsForPlatformUser(ctx context.Context, req Toke
 	//	  ]
 	//	}
 	var resp ServerResponse[map[string]BungieRewardDisplay]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Tokens.GetBungieRewardsForPlatformUser",
 		Method:   "GET",
 		PathSpec: "/Tokens/Rewards/GetRewardsForPlatformUser/{membershipId}/{membershipType}/", PathParams: map[string]string{
@@ -4877,6 +6237,15 @@ type TokensGetPartnerRewardHistoryRequest struct {
 	TargetBnetMembershipID Int64
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r TokensGetPartnerRewardHistoryRequest) Validate() error {
+	if r.TargetBnetMembershipID == 0 {
+		return invalidRequest("TokensGetPartnerRewardHistoryRequest", "TargetBnetMembershipID", "required")
+	}
+	return nil
+}
+
 // TokensGetPartnerRewardHistory: Returns the partner rewards history of the targeted user, both
 // partner offers and Twitch drops.
 //
@@ -4928,6 +6297,11 @@ func (a API) TokensGetPartnerRewardHistory(ctx context.Context, req TokensGetPar
 	//	  ]
 	//	}
 	var resp ServerResponse[PartnerRewardHistoryResponse]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Tokens.GetPartnerRewardHistory",
 		Method:   "GET",
 		PathSpec: "/Tokens/Partner/History/{targetBnetMembershipId}/Application/{partnerApplicationId}/", PathParams: map[string]string{
@@ -4950,6 +6324,15 @@ type TokensGetPartnerOfferSkuHistoryRequest struct {
 	TargetBnetMembershipID Int64
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r TokensGetPartnerOfferSkuHistoryRequest) Validate() error {
+	if r.TargetBnetMembershipID == 0 {
+		return invalidRequest("TokensGetPartnerOfferSkuHistoryRequest", "TargetBnetMembershipID", "required")
+	}
+	return nil
+}
+
 // TokensGetPartnerOfferSkuHistory: Returns the partner sku and offer history of the targeted user.
 // Elevated permissions are required to see users that are not yourself.
 //
@@ -5001,6 +6384,11 @@ func (a API) TokensGetPartnerOfferSkuHistory(ctx context.Context, req TokensGetP
 	//	  ]
 	//	}
 	var resp ServerResponse[[]PartnerOfferSkuHistoryResponse]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Tokens.GetPartnerOfferSkuHistory",
 		Method:   "GET",
 		PathSpec: "/Tokens/Partner/History/{partnerApplicationId}/{targetBnetMembershipId}/", PathParams: map[string]string{
@@ -5023,6 +6411,15 @@ type TokensApplyMissingPartnerOffersWithoutClaimRequest struct {
 	TargetBnetMembershipID Int64
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r TokensApplyMissingPartnerOffersWithoutClaimRequest) Validate() error {
+	if r.TargetBnetMembershipID == 0 {
+		return invalidRequest("TokensApplyMissingPartnerOffersWithoutClaimRequest", "TargetBnetMembershipID", "required")
+	}
+	return nil
+}
+
 // TokensApplyMissingPartnerOffersWithoutClaim: Apply a partner offer to the targeted user. This
 // endpoint does not claim a new offer, but any already claimed offers will be applied to the game if
 // not already.
@@ -5075,6 +6472,11 @@ func (a API) TokensApplyMissingPartnerOffersWithoutClaim(ctx context.Context, re
 	//	  ]
 	//	}
 	var resp ServerResponse[bool]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Tokens.ApplyMissingPartnerOffersWithoutClaim",
 		Method:   "POST",
 		PathSpec: "/Tokens/Partner/ApplyMissingOffers/{partnerApplicationId}/{targetBnetMembershipId}/", PathParams: map[string]string{
@@ -5097,6 +6499,18 @@ type SocialGetPlatformFriendListRequest struct {
 	Page string
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r SocialGetPlatformFriendListRequest) Validate() error {
+	if !r.FriendPlatform.Valid() {
+		return invalidRequest("SocialGetPlatformFriendListRequest", "FriendPlatform", fmt.Sprintf("undefined PlatformFriendType %d", r.FriendPlatform))
+	}
+	if r.Page == "" {
+		return invalidRequest("SocialGetPlatformFriendListRequest", "Page", "required")
+	}
+	return nil
+}
+
 // SocialGetPlatformFriendList: Gets the platform friend of the requested type, with additional
 // information if they have Bungie accounts. Must have a recent login session with said platform.
 //
@@ -5142,6 +6556,11 @@ func (a API) SocialGetPlatformFriendList(ctx context.Context, req SocialGetPlatf
 	//	  ]
 	//	}
 	var resp ServerResponse[PlatformFriendResponse]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Social.GetPlatformFriendList",
 		Method:   "GET",
 		PathSpec: "/Social/PlatformFriends/{friendPlatform}/{page}/", PathParams: map[string]string{
@@ -5167,6 +6586,18 @@ type GroupV2EditOptionalConversationRequest struct {
 	Body GroupOptionalConversationEditRequestBody
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r GroupV2EditOptionalConversationRequest) Validate() error {
+	if r.ConversationID == 0 {
+		return invalidRequest("GroupV2EditOptionalConversationRequest", "ConversationID", "required")
+	}
+	if r.GroupID == 0 {
+		return invalidRequest("GroupV2EditOptionalConversationRequest", "GroupID", "required")
+	}
+	return nil
+}
+
 // GroupV2EditOptionalConversation: Edit the settings of an optional conversation/chat channel.
 // Requires admin permissions to the group.
 //
@@ -5228,6 +6659,11 @@ func (a API) GroupV2EditOptionalConversation(ctx context.Context, req GroupV2Edi
 	//	  ]
 	//	}
 	var resp ServerResponse[Int64]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "GroupV2.EditOptionalConversation",
 		Method:   "POST",
 		PathSpec: "/GroupV2/{groupId}/OptionalConversations/Edit/{conversationId}/", PathParams: map[string]string{
@@ -5250,6 +6686,18 @@ type GroupV2GetRecommendedGroupsRequest struct {
 	GroupType GroupType
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r GroupV2GetRecommendedGroupsRequest) Validate() error {
+	if !r.CreateDateRange.Valid() {
+		return invalidRequest("GroupV2GetRecommendedGroupsRequest", "CreateDateRange", fmt.Sprintf("undefined GroupDateRange %d", r.CreateDateRange))
+	}
+	if !r.GroupType.Valid() {
+		return invalidRequest("GroupV2GetRecommendedGroupsRequest", "GroupType", fmt.Sprintf("undefined GroupType %d", r.GroupType))
+	}
+	return nil
+}
+
 // GroupV2GetRecommendedGroups: Gets groups recommended for you based on the groups to whom those you
 // follow belong.
 //
@@ -5309,6 +6757,11 @@ func (a API) GroupV2GetRecommendedGroups(ctx context.Context, req GroupV2GetReco
 	//	  ]
 	//	}
 	var resp ServerResponse[[]GroupV2Card]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "GroupV2.GetRecommendedGroups",
 		Method:   "POST",
 		PathSpec: "/GroupV2/Recommended/{groupType}/{createDateRange}/", PathParams: map[string]string{
@@ -5330,6 +6783,18 @@ type GroupV2GetGroupByNameRequest struct {
 	GroupType GroupType
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r GroupV2GetGroupByNameRequest) Validate() error {
+	if r.GroupName == "" {
+		return invalidRequest("GroupV2GetGroupByNameRequest", "GroupName", "required")
+	}
+	if !r.GroupType.Valid() {
+		return invalidRequest("GroupV2GetGroupByNameRequest", "GroupType", fmt.Sprintf("undefined GroupType %d", r.GroupType))
+	}
+	return nil
+}
+
 // GroupV2GetGroupByName: Get information about a specific group with the given name and type.
 //
 // URL: /GroupV2/Name/{groupName}/{groupType}/
@@ -5374,6 +6839,11 @@ func (a API) GroupV2GetGroupByName(ctx context.Context, req GroupV2GetGroupByNam
 	//	  ]
 	//	}
 	var resp ServerResponse[GroupResponse]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "GroupV2.GetGroupByName",
 		Method:   "GET",
 		PathSpec: "/GroupV2/Name/{groupName}/{groupType}/", PathParams: map[string]string{
@@ -5395,6 +6865,18 @@ type FireteamGetClanFireteamRequest struct {
 	GroupID Int64
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r FireteamGetClanFireteamRequest) Validate() error {
+	if r.FireteamID == 0 {
+		return invalidRequest("FireteamGetClanFireteamRequest", "FireteamID", "required")
+	}
+	if r.GroupID == 0 {
+		return invalidRequest("FireteamGetClanFireteamRequest", "GroupID", "required")
+	}
+	return nil
+}
+
 // FireteamGetClanFireteam: Gets a specific fireteam.
 //
 // URL: /Fireteam/Clan/{groupId}/Summary/{fireteamId}/
@@ -5445,6 +6927,11 @@ func (a API) FireteamGetClanFireteam(ctx context.Context, req FireteamGetClanFir
 	//	  ]
 	//	}
 	var resp ServerResponse[FireteamResponse]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Fireteam.GetClanFireteam",
 		Method:   "GET",
 		PathSpec: "/Fireteam/Clan/{groupId}/Summary/{fireteamId}/", PathParams: map[string]string{
@@ -5472,6 +6959,21 @@ type Destiny2GetLinkedProfilesRequest struct {
 	MembershipType BungieMembershipType
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2GetLinkedProfilesRequest) Validate() error {
+	if r.MembershipID == 0 {
+		return invalidRequest("Destiny2GetLinkedProfilesRequest", "MembershipID", "required")
+	}
+	if r.MembershipType == BungieMembershipType_None {
+		return invalidRequest("Destiny2GetLinkedProfilesRequest", "MembershipType", "required")
+	}
+	if !r.MembershipType.Valid() {
+		return invalidRequest("Destiny2GetLinkedProfilesRequest", "MembershipType", fmt.Sprintf("undefined BungieMembershipType %d", r.MembershipType))
+	}
+	return nil
+}
+
 // Destiny2GetLinkedProfiles: Returns a summary information about all profiles linked to the requesting
 // membership type/membership ID that have valid Destiny information. The passed-in Membership
 // Type/Membership ID may be a Bungie.Net membership or a Destiny membership. It only returns the
@@ -5531,6 +7033,11 @@ func (a API) Destiny2GetLinkedProfiles(ctx context.Context, req Destiny2GetLinke
 	//	  ]
 	//	}
 	var resp ServerResponse[LinkedProfilesResponse]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.GetLinkedProfiles",
 		Method:   "GET",
 		PathSpec: "/Destiny2/{membershipType}/Profile/{membershipId}/LinkedProfiles/", PathParams: map[string]string{
@@ -5559,6 +7066,26 @@ type Destiny2GetProfileRequest struct {
 	MembershipType BungieMembershipType
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2GetProfileRequest) Validate() error {
+	for _, v := range r.Components {
+		if !v.Valid() {
+			return invalidRequest("Destiny2GetProfileRequest", "Components", fmt.Sprintf("undefined ComponentType %d", v))
+		}
+	}
+	if r.DestinyMembershipID == 0 {
+		return invalidRequest("Destiny2GetProfileRequest", "DestinyMembershipID", "required")
+	}
+	if r.MembershipType == BungieMembershipType_None {
+		return invalidRequest("Destiny2GetProfileRequest", "MembershipType", "required")
+	}
+	if !r.MembershipType.Valid() {
+		return invalidRequest("Destiny2GetProfileRequest", "MembershipType", fmt.Sprintf("undefined BungieMembershipType %d", r.MembershipType))
+	}
+	return nil
+}
+
 // Destiny2GetProfile: Returns Destiny Profile information for the supplied membership.
 //
 // URL: /Destiny2/{membershipType}/Profile/{destinyMembershipId}/
@@ -5622,6 +7149,11 @@ func (a API) Destiny2GetProfile(ctx context.Context, req Destiny2GetProfileReque
 	//	  ]
 	//	}
 	var resp ServerResponse[ProfileResponse]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.GetProfile",
 		Method:   "GET",
 		PathSpec: "/Destiny2/{membershipType}/Profile/{destinyMembershipId}/", PathParams: map[string]string{
@@ -5655,6 +7187,21 @@ type Destiny2GetLeaderboardsRequest struct {
 	Statid string
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2GetLeaderboardsRequest) Validate() error {
+	if r.DestinyMembershipID == 0 {
+		return invalidRequest("Destiny2GetLeaderboardsRequest", "DestinyMembershipID", "required")
+	}
+	if r.MembershipType == BungieMembershipType_None {
+		return invalidRequest("Destiny2GetLeaderboardsRequest", "MembershipType", "required")
+	}
+	if !r.MembershipType.Valid() {
+		return invalidRequest("Destiny2GetLeaderboardsRequest", "MembershipType", fmt.Sprintf("undefined BungieMembershipType %d", r.MembershipType))
+	}
+	return nil
+}
+
 // Destiny2GetLeaderboards: Gets leaderboards with the signed in user's friends and the supplied
 // destinyMembershipId as the focus. PREVIEW: This endpoint has not yet been implemented. It is being
 // returned for a preview of future functionality, and for public comment/suggestion/preparation.
@@ -5730,6 +7277,11 @@ func (a API) Destiny2GetLeaderboards(ctx context.Context, req Destiny2GetLeaderb
 	//	  "x-preview": true
 	//	}
 	var resp ServerResponse[map[string]map[string]Leaderboard]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.GetLeaderboards",
 		Method:   "GET",
 		PathSpec: "/Destiny2/{membershipType}/Account/{destinyMembershipId}/Stats/Leaderboards/", PathParams: map[string]string{
@@ -5760,6 +7312,26 @@ type Destiny2GetHistoricalStatsForAccountRequest struct {
 	MembershipType BungieMembershipType
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2GetHistoricalStatsForAccountRequest) Validate() error {
+	if r.DestinyMembershipID == 0 {
+		return invalidRequest("Destiny2GetHistoricalStatsForAccountRequest", "DestinyMembershipID", "required")
+	}
+	for _, v := range r.Groups {
+		if !v.Valid() {
+			return invalidRequest("Destiny2GetHistoricalStatsForAccountRequest", "Groups", fmt.Sprintf("undefined StatsGroupType %d", v))
+		}
+	}
+	if r.MembershipType == BungieMembershipType_None {
+		return invalidRequest("Destiny2GetHistoricalStatsForAccountRequest", "MembershipType", "required")
+	}
+	if !r.MembershipType.Valid() {
+		return invalidRequest("Destiny2GetHistoricalStatsForAccountRequest", "MembershipType", fmt.Sprintf("undefined BungieMembershipType %d", r.MembershipType))
+	}
+	return nil
+}
+
 // Destiny2GetHistoricalStatsForAccount: Gets aggregate historical stats organized around each
 // character for a given account.
 //
@@ -5824,6 +7396,11 @@ func (a API) Destiny2GetHistoricalStatsForAccount(ctx context.Context, req Desti
 	//	  ]
 	//	}
 	var resp ServerResponse[HistoricalStatsAccountResult]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.GetHistoricalStatsForAccount",
 		Method:   "GET",
 		PathSpec: "/Destiny2/{membershipType}/Account/{destinyMembershipId}/Stats/", PathParams: map[string]string{
@@ -5852,6 +7429,18 @@ type Destiny2GetDestinyEntityDefinitionRequest struct {
 	HashIdentifier uint32
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2GetDestinyEntityDefinitionRequest) Validate() error {
+	if r.EntityType == "" {
+		return invalidRequest("Destiny2GetDestinyEntityDefinitionRequest", "EntityType", "required")
+	}
+	if r.HashIdentifier == 0 {
+		return invalidRequest("Destiny2GetDestinyEntityDefinitionRequest", "HashIdentifier", "required")
+	}
+	return nil
+}
+
 // Destiny2GetDestinyEntityDefinition: Returns the static definition of an entity of the given Type and
 // hash identifier. Examine the API Documentation for the Type Names of entities that have their own
 // definitions. Note that the return type will always *inherit from* DestinyDefinition, but the
@@ -5897,6 +7486,11 @@ func (a API) Destiny2GetDestinyEntityDefinition(ctx context.Context, req Destiny
 	//	  ]
 	//	}
 	var resp ServerResponse[Definition]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.GetDestinyEntityDefinition",
 		Method:   "GET",
 		PathSpec: "/Destiny2/Manifest/{entityType}/{hashIdentifier}/", PathParams: map[string]string{
@@ -5924,6 +7518,18 @@ type Destiny2SearchDestinyEntitiesRequest struct {
 	Type string
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2SearchDestinyEntitiesRequest) Validate() error {
+	if r.SearchTerm == "" {
+		return invalidRequest("Destiny2SearchDestinyEntitiesRequest", "SearchTerm", "required")
+	}
+	if r.Type == "" {
+		return invalidRequest("Destiny2SearchDestinyEntitiesRequest", "Type", "required")
+	}
+	return nil
+}
+
 // Destiny2SearchDestinyEntities: Gets a page list of Destiny items.
 //
 // URL: /Destiny2/Armory/Search/{type}/{searchTerm}/
@@ -5972,6 +7578,11 @@ func (a API) Destiny2SearchDestinyEntities(ctx context.Context, req Destiny2Sear
 	//	  ]
 	//	}
 	var resp ServerResponse[EntitySearchResult]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.SearchDestinyEntities",
 		Method:   "GET",
 		PathSpec: "/Destiny2/Armory/Search/{type}/{searchTerm}/", PathParams: map[string]string{
@@ -5993,6 +7604,18 @@ type ContentSearchHelpArticlesRequest struct {
 	Size string
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r ContentSearchHelpArticlesRequest) Validate() error {
+	if r.Searchtext == "" {
+		return invalidRequest("ContentSearchHelpArticlesRequest", "Searchtext", "required")
+	}
+	if r.Size == "" {
+		return invalidRequest("ContentSearchHelpArticlesRequest", "Size", "required")
+	}
+	return nil
+}
+
 // ContentSearchHelpArticles: Search for Help Articles.
 //
 // URL: /Content/SearchHelpArticles/{searchtext}/{size}/
@@ -6030,6 +7653,11 @@ func (a API) ContentSearchHelpArticles(ctx context.Context, req ContentSearchHel
 	//	  ]
 	//	}
 	var resp ServerResponse[any]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Content.SearchHelpArticles",
 		Method:   "GET",
 		PathSpec: "/Content/SearchHelpArticles/{searchtext}/{size}/", PathParams: map[string]string{
@@ -6052,6 +7680,18 @@ type ContentGetContentByIdRequest struct {
 	Locale string
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r ContentGetContentByIdRequest) Validate() error {
+	if r.Id == 0 {
+		return invalidRequest("ContentGetContentByIdRequest", "Id", "required")
+	}
+	if r.Locale == "" {
+		return invalidRequest("ContentGetContentByIdRequest", "Locale", "required")
+	}
+	return nil
+}
+
 // ContentGetContentById: Returns a content item referenced by id
 //
 // URL: /Content/GetContentById/{id}/{locale}/
@@ -6098,6 +7738,11 @@ func (a API) ContentGetContentById(ctx context.Context, req ContentGetContentByI
 	//	  ]
 	//	}
 	var resp ServerResponse[ContentItemPublicContract]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Content.GetContentById",
 		Method:   "GET",
 		PathSpec: "/Content/GetContentById/{id}/{locale}/", PathParams: map[string]string{
@@ -6124,6 +7769,24 @@ type GroupV2UnbanMemberRequest struct {
 	MembershipType BungieMembershipType
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r GroupV2UnbanMemberRequest) Validate() error {
+	if r.GroupID == 0 {
+		return invalidRequest("GroupV2UnbanMemberRequest", "GroupID", "required")
+	}
+	if r.MembershipID == 0 {
+		return invalidRequest("GroupV2UnbanMemberRequest", "MembershipID", "required")
+	}
+	if r.MembershipType == BungieMembershipType_None {
+		return invalidRequest("GroupV2UnbanMemberRequest", "MembershipType", "required")
+	}
+	if !r.MembershipType.Valid() {
+		return invalidRequest("GroupV2UnbanMemberRequest", "MembershipType", fmt.Sprintf("undefined BungieMembershipType %d", r.MembershipType))
+	}
+	return nil
+}
+
 // GroupV2UnbanMember: Unbans the requested member, allowing them to re-apply for membership.
 //
 // URL: /GroupV2/{groupId}/Members/{membershipType}/{membershipId}/Unban/
@@ -6188,6 +7851,11 @@ func (a API) GroupV2UnbanMember(ctx context.Context, req GroupV2UnbanMemberReque
 	//	  ]
 	//	}
 	var resp ServerResponse[int32]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "GroupV2.UnbanMember",
 		Method:   "POST",
 		PathSpec: "/GroupV2/{groupId}/Members/{membershipType}/{membershipId}/Unban/", PathParams: map[string]string{
@@ -6214,6 +7882,24 @@ type GroupV2KickMemberRequest struct {
 	MembershipType BungieMembershipType
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r GroupV2KickMemberRequest) Validate() error {
+	if r.GroupID == 0 {
+		return invalidRequest("GroupV2KickMemberRequest", "GroupID", "required")
+	}
+	if r.MembershipID == 0 {
+		return invalidRequest("GroupV2KickMemberRequest", "MembershipID", "required")
+	}
+	if r.MembershipType == BungieMembershipType_None {
+		return invalidRequest("GroupV2KickMemberRequest", "MembershipType", "required")
+	}
+	if !r.MembershipType.Valid() {
+		return invalidRequest("GroupV2KickMemberRequest", "MembershipType", fmt.Sprintf("undefined BungieMembershipType %d", r.MembershipType))
+	}
+	return nil
+}
+
 // GroupV2KickMember: Kick a member from the given group, forcing them to reapply if they wish to
 // re-join the group. You must have suitable permissions in the group to perform this operation.
 //
@@ -6280,6 +7966,11 @@ func (a API) GroupV2KickMember(ctx context.Context, req GroupV2KickMemberRequest
 	//	  ]
 	//	}
 	var resp ServerResponse[GroupMemberLeaveResult]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "GroupV2.KickMember",
 		Method:   "POST",
 		PathSpec: "/GroupV2/{groupId}/Members/{membershipType}/{membershipId}/Kick/", PathParams: map[string]string{
@@ -6309,6 +8000,24 @@ type GroupV2BanMemberRequest struct {
 	Body GroupBanRequestBody
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r GroupV2BanMemberRequest) Validate() error {
+	if r.GroupID == 0 {
+		return invalidRequest("GroupV2BanMemberRequest", "GroupID", "required")
+	}
+	if r.MembershipID == 0 {
+		return invalidRequest("GroupV2BanMemberRequest", "MembershipID", "required")
+	}
+	if r.MembershipType == BungieMembershipType_None {
+		return invalidRequest("GroupV2BanMemberRequest", "MembershipType", "required")
+	}
+	if !r.MembershipType.Valid() {
+		return invalidRequest("GroupV2BanMemberRequest", "MembershipType", fmt.Sprintf("undefined BungieMembershipType %d", r.MembershipType))
+	}
+	return nil
+}
+
 // GroupV2BanMember: Bans the requested member from the requested group for the specified period of
 // time.
 //
@@ -6385,6 +8094,11 @@ func (a API) GroupV2BanMember(ctx context.Context, req GroupV2BanMemberRequest)
 	//	  ]
 	//	}
 	var resp ServerResponse[int32]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "GroupV2.BanMember",
 		Method:   "POST",
 		PathSpec: "/GroupV2/{groupId}/Members/{membershipType}/{membershipId}/Ban/", PathParams: map[string]string{
@@ -6412,6 +8126,24 @@ type GroupV2IndividualGroupInviteCancelRequest struct {
 	MembershipType BungieMembershipType
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r GroupV2IndividualGroupInviteCancelRequest) Validate() error {
+	if r.GroupID == 0 {
+		return invalidRequest("GroupV2IndividualGroupInviteCancelRequest", "GroupID", "required")
+	}
+	if r.MembershipID == 0 {
+		return invalidRequest("GroupV2IndividualGroupInviteCancelRequest", "MembershipID", "required")
+	}
+	if r.MembershipType == BungieMembershipType_None {
+		return invalidRequest("GroupV2IndividualGroupInviteCancelRequest", "MembershipType", "required")
+	}
+	if !r.MembershipType.Valid() {
+		return invalidRequest("GroupV2IndividualGroupInviteCancelRequest", "MembershipType", fmt.Sprintf("undefined BungieMembershipType %d", r.MembershipType))
+	}
+	return nil
+}
+
 // GroupV2IndividualGroupInviteCancel: Cancels a pending invitation to join a group.
 //
 // URL: /GroupV2/{groupId}/Members/IndividualInviteCancel/{membershipType}/{membershipId}/
@@ -6477,6 +8209,11 @@ func (a API) GroupV2IndividualGroupInviteCancel(ctx context.Context, req GroupV2
 	//	  ]
 	//	}
 	var resp ServerResponse[GroupApplicationResponse]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "GroupV2.IndividualGroupInviteCancel",
 		Method:   "POST",
 		PathSpec: "/GroupV2/{groupId}/Members/IndividualInviteCancel/{membershipType}/{membershipId}/", PathParams: map[string]string{
@@ -6507,6 +8244,24 @@ type GroupV2IndividualGroupInviteRequest struct {
 	Body GroupApplicationRequestBody
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r GroupV2IndividualGroupInviteRequest) Validate() error {
+	if r.GroupID == 0 {
+		return invalidRequest("GroupV2IndividualGroupInviteRequest", "GroupID", "required")
+	}
+	if r.MembershipID == 0 {
+		return invalidRequest("GroupV2IndividualGroupInviteRequest", "MembershipID", "required")
+	}
+	if r.MembershipType == BungieMembershipType_None {
+		return invalidRequest("GroupV2IndividualGroupInviteRequest", "MembershipType", "required")
+	}
+	if !r.MembershipType.Valid() {
+		return invalidRequest("GroupV2IndividualGroupInviteRequest", "MembershipType", fmt.Sprintf("undefined BungieMembershipType %d", r.MembershipType))
+	}
+	return nil
+}
+
 // GroupV2IndividualGroupInvite: Invite a user to join this group.
 //
 // URL: /GroupV2/{groupId}/Members/IndividualInvite/{membershipType}/{membershipId}/
@@ -6582,6 +8337,11 @@ func (a API) GroupV2IndividualGroupInvite(ctx context.Context, req GroupV2Indivi
 	//	  ]
 	//	}
 	var resp ServerResponse[GroupApplicationResponse]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "GroupV2.IndividualGroupInvite",
 		Method:   "POST",
 		PathSpec: "/GroupV2/{groupId}/Members/IndividualInvite/{membershipType}/{membershipId}/", PathParams: map[string]string{
@@ -6611,6 +8371,24 @@ type GroupV2ApprovePendingRequest struct {
 	Body GroupApplicationRequestBody
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r GroupV2ApprovePendingRequest) Validate() error {
+	if r.GroupID == 0 {
+		return invalidRequest("GroupV2ApprovePendingRequest", "GroupID", "required")
+	}
+	if r.MembershipID == 0 {
+		return invalidRequest("GroupV2ApprovePendingRequest", "MembershipID", "required")
+	}
+	if r.MembershipType == BungieMembershipType_None {
+		return invalidRequest("GroupV2ApprovePendingRequest", "MembershipType", "required")
+	}
+	if !r.MembershipType.Valid() {
+		return invalidRequest("GroupV2ApprovePendingRequest", "MembershipType", fmt.Sprintf("undefined BungieMembershipType %d", r.MembershipType))
+	}
+	return nil
+}
+
 // GroupV2ApprovePending: Approve the given membershipId to join the group/clan as long as they have
 // applied.
 //
@@ -6687,6 +8465,11 @@ func (a API) GroupV2ApprovePending(ctx context.Context, req GroupV2ApprovePendin
 	//	  ]
 	//	}
 	var resp ServerResponse[bool]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "GroupV2.ApprovePending",
 		Method:   "POST",
 		PathSpec: "/GroupV2/{groupId}/Members/Approve/{membershipType}/{membershipId}/", PathParams: map[string]string{
@@ -6714,6 +8497,24 @@ type GroupV2AbdicateFoundershipRequest struct {
 	MembershipType BungieMembershipType
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r GroupV2AbdicateFoundershipRequest) Validate() error {
+	if r.FounderIdNew == 0 {
+		return invalidRequest("GroupV2AbdicateFoundershipRequest", "FounderIdNew", "required")
+	}
+	if r.GroupID == 0 {
+		return invalidRequest("GroupV2AbdicateFoundershipRequest", "GroupID", "required")
+	}
+	if r.MembershipType == BungieMembershipType_None {
+		return invalidRequest("GroupV2AbdicateFoundershipRequest", "MembershipType", "required")
+	}
+	if !r.MembershipType.Valid() {
+		return invalidRequest("GroupV2AbdicateFoundershipRequest", "MembershipType", fmt.Sprintf("undefined BungieMembershipType %d", r.MembershipType))
+	}
+	return nil
+}
+
 // GroupV2AbdicateFoundership: An administrative method to allow the founder of a group or clan to give
 // up their position to another admin permanently.
 //
@@ -6771,6 +8572,11 @@ func (a API) GroupV2AbdicateFoundership(ctx context.Context, req GroupV2Abdicate
 	//	  ]
 	//	}
 	var resp ServerResponse[bool]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "GroupV2.AbdicateFoundership",
 		Method:   "POST",
 		PathSpec: "/GroupV2/{groupId}/Admin/AbdicateFoundership/{membershipType}/{founderIdNew}/", PathParams: map[string]string{
@@ -6798,6 +8604,24 @@ type GroupV2RecoverGroupForFounderRequest struct {
 	MembershipType BungieMembershipType
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r GroupV2RecoverGroupForFounderRequest) Validate() error {
+	if !r.GroupType.Valid() {
+		return invalidRequest("GroupV2RecoverGroupForFounderRequest", "GroupType", fmt.Sprintf("undefined GroupType %d", r.GroupType))
+	}
+	if r.MembershipID == 0 {
+		return invalidRequest("GroupV2RecoverGroupForFounderRequest", "MembershipID", "required")
+	}
+	if r.MembershipType == BungieMembershipType_None {
+		return invalidRequest("GroupV2RecoverGroupForFounderRequest", "MembershipType", "required")
+	}
+	if !r.MembershipType.Valid() {
+		return invalidRequest("GroupV2RecoverGroupForFounderRequest", "MembershipType", fmt.Sprintf("undefined BungieMembershipType %d", r.MembershipType))
+	}
+	return nil
+}
+
 // GroupV2RecoverGroupForFounder: Allows a founder to manually recover a group they can see in game but
 // not on bungie.net
 //
@@ -6859,6 +8683,11 @@ func (a API) GroupV2RecoverGroupForFounder(ctx context.Context, req GroupV2Recov
 	//	  ]
 	//	}
 	var resp ServerResponse[GroupMembershipSearchResponse]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "GroupV2.RecoverGroupForFounder",
 		Method:   "GET",
 		PathSpec: "/GroupV2/Recover/{membershipType}/{membershipId}/{groupType}/", PathParams: map[string]string{
@@ -6890,6 +8719,29 @@ type Destiny2GetItemRequest struct {
 	MembershipType BungieMembershipType
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2GetItemRequest) Validate() error {
+	for _, v := range r.Components {
+		if !v.Valid() {
+			return invalidRequest("Destiny2GetItemRequest", "Components", fmt.Sprintf("undefined ComponentType %d", v))
+		}
+	}
+	if r.DestinyMembershipID == 0 {
+		return invalidRequest("Destiny2GetItemRequest", "DestinyMembershipID", "required")
+	}
+	if r.ItemInstanceID == 0 {
+		return invalidRequest("Destiny2GetItemRequest", "ItemInstanceID", "required")
+	}
+	if r.MembershipType == BungieMembershipType_None {
+		return invalidRequest("Destiny2GetItemRequest", "MembershipType", "required")
+	}
+	if !r.MembershipType.Valid() {
+		return invalidRequest("Destiny2GetItemRequest", "MembershipType", fmt.Sprintf("undefined BungieMembershipType %d", r.MembershipType))
+	}
+	return nil
+}
+
 // Destiny2GetItem: Retrieve the details of an instanced Destiny Item. An instanced Destiny item is one
 // with an ItemInstanceId. Non-instanced items, such as materials, have no useful instance-specific
 // details and thus are not queryable here.
@@ -6965,6 +8817,11 @@ func (a API) Destiny2GetItem(ctx context.Context, req Destiny2GetItemRequest) (*
 	//	  ]
 	//	}
 	var resp ServerResponse[ItemResponse]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.GetItem",
 		Method:   "GET",
 		PathSpec: "/Destiny2/{membershipType}/Profile/{destinyMembershipId}/Item/{itemInstanceId}/", PathParams: map[string]string{
@@ -7001,6 +8858,32 @@ type Destiny2GetVendorsRequest struct {
 	MembershipType BungieMembershipType
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2GetVendorsRequest) Validate() error {
+	if r.CharacterID == 0 {
+		return invalidRequest("Destiny2GetVendorsRequest", "CharacterID", "required")
+	}
+	for _, v := range r.Components {
+		if !v.Valid() {
+			return invalidRequest("Destiny2GetVendorsRequest", "Components", fmt.Sprintf("undefined ComponentType %d", v))
+		}
+	}
+	if r.DestinyMembershipID == 0 {
+		return invalidRequest("Destiny2GetVendorsRequest", "DestinyMembershipID", "required")
+	}
+	if r.Filter != 0 && !r.Filter.Valid() {
+		return invalidRequest("Destiny2GetVendorsRequest", "Filter", fmt.Sprintf("undefined VendorFilter %d", r.Filter))
+	}
+	if r.MembershipType == BungieMembershipType_None {
+		return invalidRequest("Destiny2GetVendorsRequest", "MembershipType", "required")
+	}
+	if !r.MembershipType.Valid() {
+		return invalidRequest("Destiny2GetVendorsRequest", "MembershipType", fmt.Sprintf("undefined BungieMembershipType %d", r.MembershipType))
+	}
+	return nil
+}
+
 // Destiny2GetVendors: Get currently available vendors from the list of vendors that can possibly have
 // rotating inventory. Note that this does not include things like preview vendors and
 // vendors-as-kiosks, neither of whom have rotating/dynamic inventories. Use their definitions as-is
@@ -7091,6 +8974,11 @@ func (a API) Destiny2GetVendors(ctx context.Context, req Destiny2GetVendorsReque
 	//	  ]
 	//	}
 	var resp ServerResponse[VendorsResponse]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.GetVendors",
 		Method:   "GET",
 		PathSpec: "/Destiny2/{membershipType}/Profile/{destinyMembershipId}/Character/{characterId}/Vendors/", PathParams: map[string]string{
@@ -7125,6 +9013,29 @@ type Destiny2GetCharacterRequest struct {
 	MembershipType BungieMembershipType
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2GetCharacterRequest) Validate() error {
+	if r.CharacterID == 0 {
+		return invalidRequest("Destiny2GetCharacterRequest", "CharacterID", "required")
+	}
+	for _, v := range r.Components {
+		if !v.Valid() {
+			return invalidRequest("Destiny2GetCharacterRequest", "Components", fmt.Sprintf("undefined ComponentType %d", v))
+		}
+	}
+	if r.DestinyMembershipID == 0 {
+		return invalidRequest("Destiny2GetCharacterRequest", "DestinyMembershipID", "required")
+	}
+	if r.MembershipType == BungieMembershipType_None {
+		return invalidRequest("Destiny2GetCharacterRequest", "MembershipType", "required")
+	}
+	if !r.MembershipType.Valid() {
+		return invalidRequest("Destiny2GetCharacterRequest", "MembershipType", fmt.Sprintf("undefined BungieMembershipType %d", r.MembershipType))
+	}
+	return nil
+}
+
 // Destiny2GetCharacter: Returns character information for the supplied character.
 //
 // URL: /Destiny2/{membershipType}/Profile/{destinyMembershipId}/Character/{characterId}/
@@ -7198,6 +9109,11 @@ func (a API) Destiny2GetCharacter(ctx context.Context, req Destiny2GetCharacterR
 	//	  ]
 	//	}
 	var resp ServerResponse[CharacterResponse]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.GetCharacter",
 		Method:   "GET",
 		PathSpec: "/Destiny2/{membershipType}/Profile/{destinyMembershipId}/Character/{characterId}/", PathParams: map[string]string{
@@ -7227,6 +9143,24 @@ type Destiny2GetUniqueWeaponHistoryRequest struct {
 	MembershipType BungieMembershipType
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2GetUniqueWeaponHistoryRequest) Validate() error {
+	if r.CharacterID == 0 {
+		return invalidRequest("Destiny2GetUniqueWeaponHistoryRequest", "CharacterID", "required")
+	}
+	if r.DestinyMembershipID == 0 {
+		return invalidRequest("Destiny2GetUniqueWeaponHistoryRequest", "DestinyMembershipID", "required")
+	}
+	if r.MembershipType == BungieMembershipType_None {
+		return invalidRequest("Destiny2GetUniqueWeaponHistoryRequest", "MembershipType", "required")
+	}
+	if !r.MembershipType.Valid() {
+		return invalidRequest("Destiny2GetUniqueWeaponHistoryRequest", "MembershipType", fmt.Sprintf("undefined BungieMembershipType %d", r.MembershipType))
+	}
+	return nil
+}
+
 // Destiny2GetUniqueWeaponHistory: Gets details about unique weapon usage, including all exotic
 // weapons.
 //
@@ -7285,6 +9219,11 @@ func (a API) Destiny2GetUniqueWeaponHistory(ctx context.Context, req Destiny2Get
 	//	  ]
 	//	}
 	var resp ServerResponse[HistoricalWeaponStatsData]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.GetUniqueWeaponHistory",
 		Method:   "GET",
 		PathSpec: "/Destiny2/{membershipType}/Account/{destinyMembershipId}/Character/{characterId}/Stats/UniqueWeapons/", PathParams: map[string]string{
@@ -7312,6 +9251,24 @@ type Destiny2GetDestinyAggregateActivityStatsRequest struct {
 	MembershipType BungieMembershipType
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2GetDestinyAggregateActivityStatsRequest) Validate() error {
+	if r.CharacterID == 0 {
+		return invalidRequest("Destiny2GetDestinyAggregateActivityStatsRequest", "CharacterID", "required")
+	}
+	if r.DestinyMembershipID == 0 {
+		return invalidRequest("Destiny2GetDestinyAggregateActivityStatsRequest", "DestinyMembershipID", "required")
+	}
+	if r.MembershipType == BungieMembershipType_None {
+		return invalidRequest("Destiny2GetDestinyAggregateActivityStatsRequest", "MembershipType", "required")
+	}
+	if !r.MembershipType.Valid() {
+		return invalidRequest("Destiny2GetDestinyAggregateActivityStatsRequest", "MembershipType", fmt.Sprintf("undefined BungieMembershipType %d", r.MembershipType))
+	}
+	return nil
+}
+
 // Destiny2GetDestinyAggregateActivityStats: Gets all activities the character has participated in
 // together with aggregate statistics for those activities.
 //
@@ -7370,6 +9327,11 @@ func (a API) Destiny2GetDestinyAggregateActivityStats(ctx context.Context, req D
 	//	  ]
 	//	}
 	var resp ServerResponse[AggregateActivityResults]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.GetDestinyAggregateActivityStats",
 		Method:   "GET",
 		PathSpec: "/Destiny2/{membershipType}/Account/{destinyMembershipId}/Character/{characterId}/Stats/AggregateActivityStats/", PathParams: map[string]string{
@@ -7407,6 +9369,27 @@ type Destiny2GetActivityHistoryRequest struct {
 	Page int32
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2GetActivityHistoryRequest) Validate() error {
+	if r.CharacterID == 0 {
+		return invalidRequest("Destiny2GetActivityHistoryRequest", "CharacterID", "required")
+	}
+	if r.DestinyMembershipID == 0 {
+		return invalidRequest("Destiny2GetActivityHistoryRequest", "DestinyMembershipID", "required")
+	}
+	if r.MembershipType == BungieMembershipType_None {
+		return invalidRequest("Destiny2GetActivityHistoryRequest", "MembershipType", "required")
+	}
+	if !r.MembershipType.Valid() {
+		return invalidRequest("Destiny2GetActivityHistoryRequest", "MembershipType", fmt.Sprintf("undefined BungieMembershipType %d", r.MembershipType))
+	}
+	if r.Mode != 0 && !r.Mode.Valid() {
+		return invalidRequest("Destiny2GetActivityHistoryRequest", "Mode", fmt.Sprintf("undefined ActivityModeType %d", r.Mode))
+	}
+	return nil
+}
+
 // Destiny2GetActivityHistory: Gets activity history stats for indicated character.
 //
 // URL:
@@ -7496,6 +9479,11 @@ func (a API) Destiny2GetActivityHistory(ctx context.Context, req Destiny2GetActi
 	//	  ]
 	//	}
 	var resp ServerResponse[ActivityHistoryResults]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.GetActivityHistory",
 		Method:   "GET",
 		PathSpec: "/Destiny2/{membershipType}/Account/{destinyMembershipId}/Character/{characterId}/Stats/Activities/", PathParams: map[string]string{
@@ -7547,6 +9535,37 @@ type Destiny2GetHistoricalStatsRequest struct {
 	PeriodType PeriodType
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2GetHistoricalStatsRequest) Validate() error {
+	if r.CharacterID == 0 {
+		return invalidRequest("Destiny2GetHistoricalStatsRequest", "CharacterID", "required")
+	}
+	if r.DestinyMembershipID == 0 {
+		return invalidRequest("Destiny2GetHistoricalStatsRequest", "DestinyMembershipID", "required")
+	}
+	for _, v := range r.Groups {
+		if !v.Valid() {
+			return invalidRequest("Destiny2GetHistoricalStatsRequest", "Groups", fmt.Sprintf("undefined StatsGroupType %d", v))
+		}
+	}
+	if r.MembershipType == BungieMembershipType_None {
+		return invalidRequest("Destiny2GetHistoricalStatsRequest", "MembershipType", "required")
+	}
+	if !r.MembershipType.Valid() {
+		return invalidRequest("Destiny2GetHistoricalStatsRequest", "MembershipType", fmt.Sprintf("undefined BungieMembershipType %d", r.MembershipType))
+	}
+	for _, v := range r.Modes {
+		if !v.Valid() {
+			return invalidRequest("Destiny2GetHistoricalStatsRequest", "Modes", fmt.Sprintf("undefined ActivityModeType %d", v))
+		}
+	}
+	if r.PeriodType != 0 && !r.PeriodType.Valid() {
+		return invalidRequest("Destiny2GetHistoricalStatsRequest", "PeriodType", fmt.Sprintf("undefined PeriodType %d", r.PeriodType))
+	}
+	return nil
+}
+
 // Destiny2GetHistoricalStats: Gets historical stats for indicated character.
 //
 // URL: /Destiny2/{membershipType}/Account/{destinyMembershipId}/Character/{characterId}/Stats/
@@ -7668,6 +9687,11 @@ func (a API) Destiny2GetHistoricalStats(ctx context.Context, req Destiny2GetHist
 	//	  ]
 	//	}
 	var resp ServerResponse[map[string]HistoricalStatsByPeriod]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.GetHistoricalStats",
 		Method:   "GET",
 		PathSpec: "/Destiny2/{membershipType}/Account/{destinyMembershipId}/Character/{characterId}/Stats/", PathParams: map[string]string{
@@ -7711,6 +9735,24 @@ type Destiny2GetLeaderboardsForCharacterRequest struct {
 	Statid string
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2GetLeaderboardsForCharacterRequest) Validate() error {
+	if r.CharacterID == 0 {
+		return invalidRequest("Destiny2GetLeaderboardsForCharacterRequest", "CharacterID", "required")
+	}
+	if r.DestinyMembershipID == 0 {
+		return invalidRequest("Destiny2GetLeaderboardsForCharacterRequest", "DestinyMembershipID", "required")
+	}
+	if r.MembershipType == BungieMembershipType_None {
+		return invalidRequest("Destiny2GetLeaderboardsForCharacterRequest", "MembershipType", "required")
+	}
+	if !r.MembershipType.Valid() {
+		return invalidRequest("Destiny2GetLeaderboardsForCharacterRequest", "MembershipType", fmt.Sprintf("undefined BungieMembershipType %d", r.MembershipType))
+	}
+	return nil
+}
+
 // Destiny2GetLeaderboardsForCharacter: Gets leaderboards with the signed in user's friends and the
 // supplied destinyMembershipId as the focus. PREVIEW: This endpoint is still in beta, and may
 // experience rough edges. The schema is in final form, but there may be bugs that prevent desirable
@@ -7797,6 +9839,11 @@ func (a API) Destiny2GetLeaderboardsForCharacter(ctx context.Context, req Destin
 	//	  "x-preview": true
 	//	}
 	var resp ServerResponse[map[string]map[string]Leaderboard]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.GetLeaderboardsForCharacter",
 		Method:   "GET",
 		PathSpec: "/Destiny2/Stats/Leaderboards/{membershipType}/{destinyMembershipId}/{characterId}/", PathParams: map[string]string{
@@ -7834,6 +9881,21 @@ type ContentSearchContentByTagAndTypeRequest struct {
 	Type string
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r ContentSearchContentByTagAndTypeRequest) Validate() error {
+	if r.Locale == "" {
+		return invalidRequest("ContentSearchContentByTagAndTypeRequest", "Locale", "required")
+	}
+	if r.Tag == "" {
+		return invalidRequest("ContentSearchContentByTagAndTypeRequest", "Tag", "required")
+	}
+	if r.Type == "" {
+		return invalidRequest("ContentSearchContentByTagAndTypeRequest", "Type", "required")
+	}
+	return nil
+}
+
 // ContentSearchContentByTagAndType: Searches for Content Items that match the given Tag and Content
 // Type.
 //
@@ -7906,6 +9968,11 @@ func (a API) ContentSearchContentByTagAndType(ctx context.Context, req ContentSe
 	//	  ]
 	//	}
 	var resp ServerResponse[SearchResult[ContentItemPublicContract]]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Content.SearchContentByTagAndType",
 		Method:   "GET",
 		PathSpec: "/Content/SearchContentByTagAndType/{tag}/{type}/{locale}/", PathParams: map[string]string{
@@ -7920,6 +9987,23 @@ func (a API) ContentSearchContentByTagAndType(ctx context.Context, req ContentSe
 	return &resp, err
 }
 
+// ContentSearchContentByTagAndTypeAll returns an iterator over the results of every page of
+// ContentSearchContentByTagAndType, starting at the page in req. See Paginate.
+func (a API) ContentSearchContentByTagAndTypeAll(ctx context.Context, req ContentSearchContentByTagAndTypeRequest) func(yield func(ContentItemPublicContract, error) bool) {
+	return Paginate(ctx, req.Currentpage, func(ctx context.Context, page int32, token string) (Page[ContentItemPublicContract], error) {
+		req.Currentpage = page
+		resp, err := a.ContentSearchContentByTagAndType(ctx, req)
+		if err != nil {
+			return Page[ContentItemPublicContract]{}, err
+		}
+		return Page[ContentItemPublicContract]{
+			Results:           resp.Response.Results,
+			HasMore:           resp.Response.HasMore,
+			ContinuationToken: resp.Response.ReplacementContinuationToken,
+		}, nil
+	})
+}
+
 // ContentGetContentByTagAndTypeRequest are the request parameters for operation
 // Content.GetContentByTagAndType
 type ContentGetContentByTagAndTypeRequest struct {
@@ -7937,6 +10021,21 @@ type ContentGetContentByTagAndTypeRequest struct {
 	Type string
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r ContentGetContentByTagAndTypeRequest) Validate() error {
+	if r.Locale == "" {
+		return invalidRequest("ContentGetContentByTagAndTypeRequest", "Locale", "required")
+	}
+	if r.Tag == "" {
+		return invalidRequest("ContentGetContentByTagAndTypeRequest", "Tag", "required")
+	}
+	if r.Type == "" {
+		return invalidRequest("ContentGetContentByTagAndTypeRequest", "Type", "required")
+	}
+	return nil
+}
+
 // ContentGetContentByTagAndType: Returns the newest item that matches a given tag and Content Type.
 //
 // URL: /Content/GetContentByTagAndType/{tag}/{type}/{locale}/
@@ -7990,6 +10089,11 @@ func (a API) ContentGetContentByTagAndType(ctx context.Context, req ContentGetCo
 	//	  ]
 	//	}
 	var resp ServerResponse[ContentItemPublicContract]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Content.GetContentByTagAndType",
 		Method:   "GET",
 		PathSpec: "/Content/GetContentByTagAndType/{tag}/{type}/{locale}/", PathParams: map[string]string{
@@ -8019,6 +10123,15 @@ type CommunityContentGetCommunityContentRequest struct {
 	Sort CommunityContentSortMode
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r CommunityContentGetCommunityContentRequest) Validate() error {
+	if !r.Sort.Valid() {
+		return invalidRequest("CommunityContentGetCommunityContentRequest", "Sort", fmt.Sprintf("undefined CommunityContentSortMode %d", r.Sort))
+	}
+	return nil
+}
+
 // CommunityContentGetCommunityContent: Returns community content.
 //
 // URL: /CommunityContent/Get/{sort}/{mediaFilter}/{page}/
@@ -8078,6 +10191,11 @@ func (a API) CommunityContentGetCommunityContent(ctx context.Context, req Commun
 	//	  ]
 	//	}
 	var resp ServerResponse[PostSearchResponse]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "CommunityContent.GetCommunityContent",
 		Method:   "GET",
 		PathSpec: "/CommunityContent/Get/{sort}/{mediaFilter}/{page}/", PathParams: map[string]string{
@@ -8088,6 +10206,23 @@ func (a API) CommunityContentGetCommunityContent(ctx context.Context, req Commun
 	return &resp, err
 }
 
+// CommunityContentGetCommunityContentAll returns an iterator over the results of every page of
+// CommunityContentGetCommunityContent, starting at the page in req. See Paginate.
+func (a API) CommunityContentGetCommunityContentAll(ctx context.Context, req CommunityContentGetCommunityContentRequest) func(yield func(PostResponse, error) bool) {
+	return Paginate(ctx, req.Page, func(ctx context.Context, page int32, token string) (Page[PostResponse], error) {
+		req.Page = page
+		resp, err := a.CommunityContentGetCommunityContent(ctx, req)
+		if err != nil {
+			return Page[PostResponse]{}, err
+		}
+		return Page[PostResponse]{
+			Results:           resp.Response.Results,
+			HasMore:           resp.Response.HasMore,
+			ContinuationToken: resp.Response.ReplacementContinuationToken,
+		}, nil
+	})
+}
+
 // GroupV2EditGroupMembershipRequest are the request parameters for operation
 // GroupV2.EditGroupMembership
 type GroupV2EditGroupMembershipRequest struct {
@@ -8109,6 +10244,27 @@ type GroupV2EditGroupMembershipRequest struct {
 	MemberType RuntimeGroupMemberType
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r GroupV2EditGroupMembershipRequest) Validate() error {
+	if r.GroupID == 0 {
+		return invalidRequest("GroupV2EditGroupMembershipRequest", "GroupID", "required")
+	}
+	if r.MembershipID == 0 {
+		return invalidRequest("GroupV2EditGroupMembershipRequest", "MembershipID", "required")
+	}
+	if r.MembershipType == BungieMembershipType_None {
+		return invalidRequest("GroupV2EditGroupMembershipRequest", "MembershipType", "required")
+	}
+	if !r.MembershipType.Valid() {
+		return invalidRequest("GroupV2EditGroupMembershipRequest", "MembershipType", fmt.Sprintf("undefined BungieMembershipType %d", r.MembershipType))
+	}
+	if !r.MemberType.Valid() {
+		return invalidRequest("GroupV2EditGroupMembershipRequest", "MemberType", fmt.Sprintf("undefined RuntimeGroupMemberType %d", r.MemberType))
+	}
+	return nil
+}
+
 // GroupV2EditGroupMembership: Edit the membership type of a given member. You must have suitable
 // permissions in the group to perform this operation.
 //
@@ -8190,6 +10346,11 @@ func (a API) GroupV2EditGroupMembership(ctx context.Context, req GroupV2EditGrou
 	//	  ]
 	//	}
 	var resp ServerResponse[int32]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "GroupV2.EditGroupMembership",
 		Method:   "POST",
 		PathSpec: "/GroupV2/{groupId}/Members/{membershipType}/{membershipId}/SetMembershipType/{memberType}/", PathParams: map[string]string{
@@ -8221,6 +10382,27 @@ type GroupV2GetGroupsForMemberRequest struct {
 	MembershipType BungieMembershipType
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r GroupV2GetGroupsForMemberRequest) Validate() error {
+	if !r.Filter.Valid() {
+		return invalidRequest("GroupV2GetGroupsForMemberRequest", "Filter", fmt.Sprintf("undefined GroupsForMemberFilter %d", r.Filter))
+	}
+	if !r.GroupType.Valid() {
+		return invalidRequest("GroupV2GetGroupsForMemberRequest", "GroupType", fmt.Sprintf("undefined GroupType %d", r.GroupType))
+	}
+	if r.MembershipID == 0 {
+		return invalidRequest("GroupV2GetGroupsForMemberRequest", "MembershipID", "required")
+	}
+	if r.MembershipType == BungieMembershipType_None {
+		return invalidRequest("GroupV2GetGroupsForMemberRequest", "MembershipType", "required")
+	}
+	if !r.MembershipType.Valid() {
+		return invalidRequest("GroupV2GetGroupsForMemberRequest", "MembershipType", fmt.Sprintf("undefined BungieMembershipType %d", r.MembershipType))
+	}
+	return nil
+}
+
 // GroupV2GetGroupsForMember: Get information about the groups that a given member has joined.
 //
 // URL: /GroupV2/User/{membershipType}/{membershipId}/{filter}/{groupType}/
@@ -8295,6 +10477,11 @@ func (a API) GroupV2GetGroupsForMember(ctx context.Context, req GroupV2GetGroups
 	//	  ]
 	//	}
 	var resp ServerResponse[GetGroupsForMemberResponse]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "GroupV2.GetGroupsForMember",
 		Method:   "GET",
 		PathSpec: "/GroupV2/User/{membershipType}/{membershipId}/{filter}/{groupType}/", PathParams: map[string]string{
@@ -8327,6 +10514,27 @@ type GroupV2GetPotentialGroupsForMemberRequest struct {
 	MembershipType BungieMembershipType
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r GroupV2GetPotentialGroupsForMemberRequest) Validate() error {
+	if !r.Filter.Valid() {
+		return invalidRequest("GroupV2GetPotentialGroupsForMemberRequest", "Filter", fmt.Sprintf("undefined GroupPotentialMemberStatus %d", r.Filter))
+	}
+	if !r.GroupType.Valid() {
+		return invalidRequest("GroupV2GetPotentialGroupsForMemberRequest", "GroupType", fmt.Sprintf("undefined GroupType %d", r.GroupType))
+	}
+	if r.MembershipID == 0 {
+		return invalidRequest("GroupV2GetPotentialGroupsForMemberRequest", "MembershipID", "required")
+	}
+	if r.MembershipType == BungieMembershipType_None {
+		return invalidRequest("GroupV2GetPotentialGroupsForMemberRequest", "MembershipType", "required")
+	}
+	if !r.MembershipType.Valid() {
+		return invalidRequest("GroupV2GetPotentialGroupsForMemberRequest", "MembershipType", fmt.Sprintf("undefined BungieMembershipType %d", r.MembershipType))
+	}
+	return nil
+}
+
 // GroupV2GetPotentialGroupsForMember: Get information about the groups that a given member has applied
 // to or been invited to.
 //
@@ -8402,6 +10610,11 @@ func (a API) GroupV2GetPotentialGroupsForMember(ctx context.Context, req GroupV2
 	//	  ]
 	//	}
 	var resp ServerResponse[GroupPotentialMembershipSearchResponse]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "GroupV2.GetPotentialGroupsForMember",
 		Method:   "GET",
 		PathSpec: "/GroupV2/User/Potential/{membershipType}/{membershipId}/{filter}/{groupType}/", PathParams: map[string]string{
@@ -8436,6 +10649,18 @@ type ForumGetCoreTopicsPagedRequest struct {
 	Sort ForumTopicsSort
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r ForumGetCoreTopicsPagedRequest) Validate() error {
+	if !r.QuickDate.Valid() {
+		return invalidRequest("ForumGetCoreTopicsPagedRequest", "QuickDate", fmt.Sprintf("undefined ForumTopicsQuickDate %d", r.QuickDate))
+	}
+	if !r.Sort.Valid() {
+		return invalidRequest("ForumGetCoreTopicsPagedRequest", "Sort", fmt.Sprintf("undefined ForumTopicsSort %d", r.Sort))
+	}
+	return nil
+}
+
 // ForumGetCoreTopicsPaged: Gets a listing of all topics marked as part of the core group.
 //
 // URL: /Forum/GetCoreTopicsPaged/{page}/{sort}/{quickDate}/{categoryFilter}/
@@ -8517,6 +10742,11 @@ func (a API) ForumGetCoreTopicsPaged(ctx context.Context, req ForumGetCoreTopics
 	//	  ]
 	//	}
 	var resp ServerResponse[PostSearchResponse]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Forum.GetCoreTopicsPaged",
 		Method:   "GET",
 		PathSpec: "/Forum/GetCoreTopicsPaged/{page}/{sort}/{quickDate}/{categoryFilter}/", PathParams: map[string]string{
@@ -8530,6 +10760,23 @@ func (a API) ForumGetCoreTopicsPaged(ctx context.Context, req ForumGetCoreTopics
 	return &resp, err
 }
 
+// ForumGetCoreTopicsPagedAll returns an iterator over the results of every page of
+// ForumGetCoreTopicsPaged, starting at the page in req. See Paginate.
+func (a API) ForumGetCoreTopicsPagedAll(ctx context.Context, req ForumGetCoreTopicsPagedRequest) func(yield func(PostResponse, error) bool) {
+	return Paginate(ctx, req.Page, func(ctx context.Context, page int32, token string) (Page[PostResponse], error) {
+		req.Page = page
+		resp, err := a.ForumGetCoreTopicsPaged(ctx, req)
+		if err != nil {
+			return Page[PostResponse]{}, err
+		}
+		return Page[PostResponse]{
+			Results:           resp.Response.Results,
+			HasMore:           resp.Response.HasMore,
+			ContinuationToken: resp.Response.ReplacementContinuationToken,
+		}, nil
+	})
+}
+
 // FireteamGetMyClanFireteamsRequest are the request parameters for operation
 // Fireteam.GetMyClanFireteams
 type FireteamGetMyClanFireteamsRequest struct {
@@ -8558,6 +10805,18 @@ type FireteamGetMyClanFireteamsRequest struct {
 	Platform FireteamPlatform
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r FireteamGetMyClanFireteamsRequest) Validate() error {
+	if r.GroupID == 0 {
+		return invalidRequest("FireteamGetMyClanFireteamsRequest", "GroupID", "required")
+	}
+	if !r.Platform.Valid() {
+		return invalidRequest("FireteamGetMyClanFireteamsRequest", "Platform", fmt.Sprintf("undefined FireteamPlatform %d", r.Platform))
+	}
+	return nil
+}
+
 // FireteamGetMyClanFireteams: Gets a listing of all fireteams that caller is an applicant, a member,
 // or an alternate of.
 //
@@ -8649,6 +10908,11 @@ func (a API) FireteamGetMyClanFireteams(ctx context.Context, req FireteamGetMyCl
 	//	  ]
 	//	}
 	var resp ServerResponse[SearchResult[FireteamResponse]]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Fireteam.GetMyClanFireteams",
 		Method:   "GET",
 		PathSpec: "/Fireteam/Clan/{groupId}/My/{platform}/{includeClosed}/{page}/", PathParams: map[string]string{
@@ -8663,6 +10927,23 @@ func (a API) FireteamGetMyClanFireteams(ctx context.Context, req FireteamGetMyCl
 	return &resp, err
 }
 
+// FireteamGetMyClanFireteamsAll returns an iterator over the results of every page of
+// FireteamGetMyClanFireteams, starting at the page in req. See Paginate.
+func (a API) FireteamGetMyClanFireteamsAll(ctx context.Context, req FireteamGetMyClanFireteamsRequest) func(yield func(FireteamResponse, error) bool) {
+	return Paginate(ctx, req.Page, func(ctx context.Context, page int32, token string) (Page[FireteamResponse], error) {
+		req.Page = page
+		resp, err := a.FireteamGetMyClanFireteams(ctx, req)
+		if err != nil {
+			return Page[FireteamResponse]{}, err
+		}
+		return Page[FireteamResponse]{
+			Results:           resp.Response.Results,
+			HasMore:           resp.Response.HasMore,
+			ContinuationToken: resp.Response.ReplacementContinuationToken,
+		}, nil
+	})
+}
+
 // Destiny2GetVendorRequest are the request parameters for operation Destiny2.GetVendor
 type Destiny2GetVendorRequest struct {
 
@@ -8688,6 +10969,32 @@ type Destiny2GetVendorRequest struct {
 	VendorHash uint32
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2GetVendorRequest) Validate() error {
+	if r.CharacterID == 0 {
+		return invalidRequest("Destiny2GetVendorRequest", "CharacterID", "required")
+	}
+	for _, v := range r.Components {
+		if !v.Valid() {
+			return invalidRequest("Destiny2GetVendorRequest", "Components", fmt.Sprintf("undefined ComponentType %d", v))
+		}
+	}
+	if r.DestinyMembershipID == 0 {
+		return invalidRequest("Destiny2GetVendorRequest", "DestinyMembershipID", "required")
+	}
+	if r.MembershipType == BungieMembershipType_None {
+		return invalidRequest("Destiny2GetVendorRequest", "MembershipType", "required")
+	}
+	if !r.MembershipType.Valid() {
+		return invalidRequest("Destiny2GetVendorRequest", "MembershipType", fmt.Sprintf("undefined BungieMembershipType %d", r.MembershipType))
+	}
+	if r.VendorHash == 0 {
+		return invalidRequest("Destiny2GetVendorRequest", "VendorHash", "required")
+	}
+	return nil
+}
+
 // Destiny2GetVendor: Get the details of a specific Vendor.
 //
 // URL:
@@ -8772,6 +11079,11 @@ func (a API) Destiny2GetVendor(ctx context.Context, req Destiny2GetVendorRequest
 	//	  ]
 	//	}
 	var resp ServerResponse[VendorResponse]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.GetVendor",
 		Method:   "GET",
 		PathSpec: "/Destiny2/{membershipType}/Profile/{destinyMembershipId}/Character/{characterId}/Vendors/{vendorHash}/", PathParams: map[string]string{
@@ -8812,6 +11124,32 @@ type Destiny2GetCollectibleNodeDetailsRequest struct {
 	MembershipType BungieMembershipType
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r Destiny2GetCollectibleNodeDetailsRequest) Validate() error {
+	if r.CharacterID == 0 {
+		return invalidRequest("Destiny2GetCollectibleNodeDetailsRequest", "CharacterID", "required")
+	}
+	if r.CollectiblePresentationNodeHash == 0 {
+		return invalidRequest("Destiny2GetCollectibleNodeDetailsRequest", "CollectiblePresentationNodeHash", "required")
+	}
+	for _, v := range r.Components {
+		if !v.Valid() {
+			return invalidRequest("Destiny2GetCollectibleNodeDetailsRequest", "Components", fmt.Sprintf("undefined ComponentType %d", v))
+		}
+	}
+	if r.DestinyMembershipID == 0 {
+		return invalidRequest("Destiny2GetCollectibleNodeDetailsRequest", "DestinyMembershipID", "required")
+	}
+	if r.MembershipType == BungieMembershipType_None {
+		return invalidRequest("Destiny2GetCollectibleNodeDetailsRequest", "MembershipType", "required")
+	}
+	if !r.MembershipType.Valid() {
+		return invalidRequest("Destiny2GetCollectibleNodeDetailsRequest", "MembershipType", fmt.Sprintf("undefined BungieMembershipType %d", r.MembershipType))
+	}
+	return nil
+}
+
 // Destiny2GetCollectibleNodeDetails: Given a Presentation Node that has Collectibles as direct
 // descendants, this will return item details about those descendants in the context of the requesting
 // character.
@@ -8898,6 +11236,11 @@ func (a API) Destiny2GetCollectibleNodeDetails(ctx context.Context, req Destiny2
 	//	  ]
 	//	}
 	var resp ServerResponse[CollectibleNodeDetailResponse]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Destiny2.GetCollectibleNodeDetails",
 		Method:   "GET",
 		PathSpec: "/Destiny2/{membershipType}/Profile/{destinyMembershipId}/Character/{characterId}/Collectibles/{collectiblePresentationNodeHash}/", PathParams: map[string]string{
@@ -8943,6 +11286,21 @@ type FireteamSearchPublicAvailableClanFireteamsRequest struct {
 	SlotFilter FireteamSlotSearch
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r FireteamSearchPublicAvailableClanFireteamsRequest) Validate() error {
+	if !r.DateRange.Valid() {
+		return invalidRequest("FireteamSearchPublicAvailableClanFireteamsRequest", "DateRange", fmt.Sprintf("undefined FireteamDateRange %d", r.DateRange))
+	}
+	if !r.Platform.Valid() {
+		return invalidRequest("FireteamSearchPublicAvailableClanFireteamsRequest", "Platform", fmt.Sprintf("undefined FireteamPlatform %d", r.Platform))
+	}
+	if !r.SlotFilter.Valid() {
+		return invalidRequest("FireteamSearchPublicAvailableClanFireteamsRequest", "SlotFilter", fmt.Sprintf("undefined FireteamSlotSearch %d", r.SlotFilter))
+	}
+	return nil
+}
+
 // FireteamSearchPublicAvailableClanFireteams: Gets a listing of all public fireteams starting now with
 // open slots. Caller is not checked for join criteria so caching is maximized.
 //
@@ -9052,6 +11410,11 @@ func (a API) FireteamSearchPublicAvailableClanFireteams(ctx context.Context, req
 	//	  ]
 	//	}
 	var resp ServerResponse[SearchResult[FireteamSummary]]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Fireteam.SearchPublicAvailableClanFireteams",
 		Method:   "GET",
 		PathSpec: "/Fireteam/Search/Available/{platform}/{activityType}/{dateRange}/{slotFilter}/{page}/", PathParams: map[string]string{
@@ -9067,6 +11430,23 @@ func (a API) FireteamSearchPublicAvailableClanFireteams(ctx context.Context, req
 	return &resp, err
 }
 
+// FireteamSearchPublicAvailableClanFireteamsAll returns an iterator over the results of every page of
+// FireteamSearchPublicAvailableClanFireteams, starting at the page in req. See Paginate.
+func (a API) FireteamSearchPublicAvailableClanFireteamsAll(ctx context.Context, req FireteamSearchPublicAvailableClanFireteamsRequest) func(yield func(FireteamSummary, error) bool) {
+	return Paginate(ctx, req.Page, func(ctx context.Context, page int32, token string) (Page[FireteamSummary], error) {
+		req.Page = page
+		resp, err := a.FireteamSearchPublicAvailableClanFireteams(ctx, req)
+		if err != nil {
+			return Page[FireteamSummary]{}, err
+		}
+		return Page[FireteamSummary]{
+			Results:           resp.Response.Results,
+			HasMore:           resp.Response.HasMore,
+			ContinuationToken: resp.Response.ReplacementContinuationToken,
+		}, nil
+	})
+}
+
 // ForumGetTopicsPagedRequest are the request parameters for operation Forum.GetTopicsPaged
 type ForumGetTopicsPagedRequest struct {
 
@@ -9101,6 +11481,21 @@ type ForumGetTopicsPagedRequest struct {
 	Tagstring string
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r ForumGetTopicsPagedRequest) Validate() error {
+	if r.Group == 0 {
+		return invalidRequest("ForumGetTopicsPagedRequest", "Group", "required")
+	}
+	if !r.QuickDate.Valid() {
+		return invalidRequest("ForumGetTopicsPagedRequest", "QuickDate", fmt.Sprintf("undefined ForumTopicsQuickDate %d", r.QuickDate))
+	}
+	if !r.Sort.Valid() {
+		return invalidRequest("ForumGetTopicsPagedRequest", "Sort", fmt.Sprintf("undefined ForumTopicsSort %d", r.Sort))
+	}
+	return nil
+}
+
 // ForumGetTopicsPaged: Get topics from any forum.
 //
 // URL: /Forum/GetTopicsPaged/{page}/{pageSize}/{group}/{sort}/{quickDate}/{categoryFilter}/
@@ -9210,6 +11605,11 @@ func (a API) ForumGetTopicsPaged(ctx context.Context, req ForumGetTopicsPagedReq
 	//	  ]
 	//	}
 	var resp ServerResponse[PostSearchResponse]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Forum.GetTopicsPaged",
 		Method:   "GET",
 		PathSpec: "/Forum/GetTopicsPaged/{page}/{pageSize}/{group}/{sort}/{quickDate}/{categoryFilter}/", PathParams: map[string]string{
@@ -9226,6 +11626,23 @@ func (a API) ForumGetTopicsPaged(ctx context.Context, req ForumGetTopicsPagedReq
 	return &resp, err
 }
 
+// ForumGetTopicsPagedAll returns an iterator over the results of every page of ForumGetTopicsPaged,
+// starting at the page in req. See Paginate.
+func (a API) ForumGetTopicsPagedAll(ctx context.Context, req ForumGetTopicsPagedRequest) func(yield func(PostResponse, error) bool) {
+	return Paginate(ctx, req.Page, func(ctx context.Context, page int32, token string) (Page[PostResponse], error) {
+		req.Page = page
+		resp, err := a.ForumGetTopicsPaged(ctx, req)
+		if err != nil {
+			return Page[PostResponse]{}, err
+		}
+		return Page[PostResponse]{
+			Results:           resp.Response.Results,
+			HasMore:           resp.Response.HasMore,
+			ContinuationToken: resp.Response.ReplacementContinuationToken,
+		}, nil
+	})
+}
+
 // ForumGetPostsThreadedPagedFromChildRequest are the request parameters for operation
 // Forum.GetPostsThreadedPagedFromChild
 type ForumGetPostsThreadedPagedFromChildRequest struct {
@@ -9252,6 +11669,18 @@ type ForumGetPostsThreadedPagedFromChildRequest struct {
 	SortMode ForumPostSort
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r ForumGetPostsThreadedPagedFromChildRequest) Validate() error {
+	if r.ChildPostID == 0 {
+		return invalidRequest("ForumGetPostsThreadedPagedFromChildRequest", "ChildPostID", "required")
+	}
+	if !r.SortMode.Valid() {
+		return invalidRequest("ForumGetPostsThreadedPagedFromChildRequest", "SortMode", fmt.Sprintf("undefined ForumPostSort %d", r.SortMode))
+	}
+	return nil
+}
+
 // ForumGetPostsThreadedPagedFromChild: Returns a thread of posts starting at the topicId of the input
 // childPostId, optionally returning replies to those posts as well as the original parent.
 //
@@ -9340,6 +11769,11 @@ func (a API) ForumGetPostsThreadedPagedFromChild(ctx context.Context, req ForumG
 	//	  ]
 	//	}
 	var resp ServerResponse[PostSearchResponse]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Forum.GetPostsThreadedPagedFromChild",
 		Method:   "GET",
 		PathSpec: "/Forum/GetPostsThreadedPagedFromChild/{childPostId}/{page}/{pageSize}/{replySize}/{rootThreadMode}/{sortMode}/", PathParams: map[string]string{
@@ -9355,6 +11789,23 @@ func (a API) ForumGetPostsThreadedPagedFromChild(ctx context.Context, req ForumG
 	return &resp, err
 }
 
+// ForumGetPostsThreadedPagedFromChildAll returns an iterator over the results of every page of
+// ForumGetPostsThreadedPagedFromChild, starting at the page in req. See Paginate.
+func (a API) ForumGetPostsThreadedPagedFromChildAll(ctx context.Context, req ForumGetPostsThreadedPagedFromChildRequest) func(yield func(PostResponse, error) bool) {
+	return Paginate(ctx, req.Page, func(ctx context.Context, page int32, token string) (Page[PostResponse], error) {
+		req.Page = page
+		resp, err := a.ForumGetPostsThreadedPagedFromChild(ctx, req)
+		if err != nil {
+			return Page[PostResponse]{}, err
+		}
+		return Page[PostResponse]{
+			Results:           resp.Response.Results,
+			HasMore:           resp.Response.HasMore,
+			ContinuationToken: resp.Response.ReplacementContinuationToken,
+		}, nil
+	})
+}
+
 // ForumGetPostsThreadedPagedRequest are the request parameters for operation
 // Forum.GetPostsThreadedPaged
 type ForumGetPostsThreadedPagedRequest struct {
@@ -9384,6 +11835,18 @@ type ForumGetPostsThreadedPagedRequest struct {
 	SortMode ForumPostSort
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r ForumGetPostsThreadedPagedRequest) Validate() error {
+	if r.ParentPostID == 0 {
+		return invalidRequest("ForumGetPostsThreadedPagedRequest", "ParentPostID", "required")
+	}
+	if !r.SortMode.Valid() {
+		return invalidRequest("ForumGetPostsThreadedPagedRequest", "SortMode", fmt.Sprintf("undefined ForumPostSort %d", r.SortMode))
+	}
+	return nil
+}
+
 // ForumGetPostsThreadedPaged: Returns a thread of posts at the given parent, optionally returning
 // replies to those posts as well as the original parent.
 //
@@ -9480,6 +11943,11 @@ func (a API) ForumGetPostsThreadedPaged(ctx context.Context, req ForumGetPostsTh
 	//	  ]
 	//	}
 	var resp ServerResponse[PostSearchResponse]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Forum.GetPostsThreadedPaged",
 		Method:   "GET",
 		PathSpec: "/Forum/GetPostsThreadedPaged/{parentPostId}/{page}/{pageSize}/{replySize}/{getParentPost}/{rootThreadMode}/{sortMode}/", PathParams: map[string]string{
@@ -9496,6 +11964,23 @@ func (a API) ForumGetPostsThreadedPaged(ctx context.Context, req ForumGetPostsTh
 	return &resp, err
 }
 
+// ForumGetPostsThreadedPagedAll returns an iterator over the results of every page of
+// ForumGetPostsThreadedPaged, starting at the page in req. See Paginate.
+func (a API) ForumGetPostsThreadedPagedAll(ctx context.Context, req ForumGetPostsThreadedPagedRequest) func(yield func(PostResponse, error) bool) {
+	return Paginate(ctx, req.Page, func(ctx context.Context, page int32, token string) (Page[PostResponse], error) {
+		req.Page = page
+		resp, err := a.ForumGetPostsThreadedPaged(ctx, req)
+		if err != nil {
+			return Page[PostResponse]{}, err
+		}
+		return Page[PostResponse]{
+			Results:           resp.Response.Results,
+			HasMore:           resp.Response.HasMore,
+			ContinuationToken: resp.Response.ReplacementContinuationToken,
+		}, nil
+	})
+}
+
 // FireteamGetAvailableClanFireteamsRequest are the request parameters for operation
 // Fireteam.GetAvailableClanFireteams
 type FireteamGetAvailableClanFireteamsRequest struct {
@@ -9536,6 +12021,27 @@ type FireteamGetAvailableClanFireteamsRequest struct {
 	SlotFilter FireteamSlotSearch
 }
 
+// Validate checks that the required parameters of r are set and that enum parameters have values
+// defined by the API.
+func (r FireteamGetAvailableClanFireteamsRequest) Validate() error {
+	if !r.DateRange.Valid() {
+		return invalidRequest("FireteamGetAvailableClanFireteamsRequest", "DateRange", fmt.Sprintf("undefined FireteamDateRange %d", r.DateRange))
+	}
+	if r.GroupID == 0 {
+		return invalidRequest("FireteamGetAvailableClanFireteamsRequest", "GroupID", "required")
+	}
+	if !r.Platform.Valid() {
+		return invalidRequest("FireteamGetAvailableClanFireteamsRequest", "Platform", fmt.Sprintf("undefined FireteamPlatform %d", r.Platform))
+	}
+	if !r.PublicOnly.Valid() {
+		return invalidRequest("FireteamGetAvailableClanFireteamsRequest", "PublicOnly", fmt.Sprintf("undefined FireteamPublicSearchOption %d", r.PublicOnly))
+	}
+	if !r.SlotFilter.Valid() {
+		return invalidRequest("FireteamGetAvailableClanFireteamsRequest", "SlotFilter", fmt.Sprintf("undefined FireteamSlotSearch %d", r.SlotFilter))
+	}
+	return nil
+}
+
 // FireteamGetAvailableClanFireteams: Gets a listing of all of this clan's fireteams that are have
 // available slots. Caller is not checked for join criteria so caching is maximized.
 //
@@ -9670,6 +12176,11 @@ func (a API) FireteamGetAvailableClanFireteams(ctx context.Context, req Fireteam
 	//	  ]
 	//	}
 	var resp ServerResponse[SearchResult[FireteamSummary]]
+	if !a.skipValidation {
+		if err := req.Validate(); err != nil {
+			return &resp, err
+		}
+	}
 	err := a.client.Do(ctx, ClientRequest{Operation: "Fireteam.GetAvailableClanFireteams",
 		Method:   "GET",
 		PathSpec: "/Fireteam/Clan/{groupId}/Available/{platform}/{activityType}/{dateRange}/{slotFilter}/{publicOnly}/{page}/", PathParams: map[string]string{
@@ -9687,6 +12198,23 @@ func (a API) FireteamGetAvailableClanFireteams(ctx context.Context, req Fireteam
 	return &resp, err
 }
 
+// FireteamGetAvailableClanFireteamsAll returns an iterator over the results of every page of
+// FireteamGetAvailableClanFireteams, starting at the page in req. See Paginate.
+func (a API) FireteamGetAvailableClanFireteamsAll(ctx context.Context, req FireteamGetAvailableClanFireteamsRequest) func(yield func(FireteamSummary, error) bool) {
+	return Paginate(ctx, req.Page, func(ctx context.Context, page int32, token string) (Page[FireteamSummary], error) {
+		req.Page = page
+		resp, err := a.FireteamGetAvailableClanFireteams(ctx, req)
+		if err != nil {
+			return Page[FireteamSummary]{}, err
+		}
+		return Page[FireteamSummary]{
+			Results:           resp.Response.Results,
+			HasMore:           resp.Response.HasMore,
+			ContinuationToken: resp.Response.ReplacementContinuationToken,
+		}, nil
+	})
+}
+
 // Applications.ApiUsage
 type ApiUsage struct {
 	// {
@@ -48775,6 +51303,43 @@ func (e ApplicationScopes) Enum() string {
 	return fmt.Sprintf("ApplicationScopes_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ApplicationScopes) Valid() bool {
+	switch e {
+	case ApplicationScopes_ReadBasicUserProfile:
+		return true
+	case ApplicationScopes_ReadGroups:
+		return true
+	case ApplicationScopes_WriteGroups:
+		return true
+	case ApplicationScopes_AdminGroups:
+		return true
+	case ApplicationScopes_BnetWrite:
+		return true
+	case ApplicationScopes_MoveEquipDestinyItems:
+		return true
+	case ApplicationScopes_ReadDestinyInventoryAndVault:
+		return true
+	case ApplicationScopes_ReadUserData:
+		return true
+	case ApplicationScopes_EditUserData:
+		return true
+	case ApplicationScopes_ReadDestinyVendorsAndAdvisors:
+		return true
+	case ApplicationScopes_ReadAndApplyTokens:
+		return true
+	case ApplicationScopes_AdvancedWriteActions:
+		return true
+	case ApplicationScopes_PartnerOfferGrant:
+		return true
+	case ApplicationScopes_DestinyUnlockValueQuery:
+		return true
+	case ApplicationScopes_UserPiiRead:
+		return true
+	}
+	return false
+}
+
 func (e ApplicationStatus) Enum() string {
 	switch e {
 	case ApplicationStatus_None:
@@ -48791,6 +51356,23 @@ func (e ApplicationStatus) Enum() string {
 	return fmt.Sprintf("ApplicationStatus_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ApplicationStatus) Valid() bool {
+	switch e {
+	case ApplicationStatus_None:
+		return true
+	case ApplicationStatus_Private:
+		return true
+	case ApplicationStatus_Public:
+		return true
+	case ApplicationStatus_Disabled:
+		return true
+	case ApplicationStatus_Blocked:
+		return true
+	}
+	return false
+}
+
 func (e DeveloperRole) Enum() string {
 	switch e {
 	case DeveloperRole_None:
@@ -48803,6 +51385,19 @@ func (e DeveloperRole) Enum() string {
 	return fmt.Sprintf("DeveloperRole_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e DeveloperRole) Valid() bool {
+	switch e {
+	case DeveloperRole_None:
+		return true
+	case DeveloperRole_Owner:
+		return true
+	case DeveloperRole_TeamMember:
+		return true
+	}
+	return false
+}
+
 func (e OAuthApplicationType) Enum() string {
 	switch e {
 	case OAuthApplicationType_None:
@@ -48815,6 +51410,19 @@ func (e OAuthApplicationType) Enum() string {
 	return fmt.Sprintf("OAuthApplicationType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e OAuthApplicationType) Valid() bool {
+	switch e {
+	case OAuthApplicationType_None:
+		return true
+	case OAuthApplicationType_Confidential:
+		return true
+	case OAuthApplicationType_Public:
+		return true
+	}
+	return false
+}
+
 func (e BungieCredentialType) Enum() string {
 	switch e {
 	case BungieCredentialType_None:
@@ -48849,6 +51457,41 @@ func (e BungieCredentialType) Enum() string {
 	return fmt.Sprintf("BungieCredentialType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e BungieCredentialType) Valid() bool {
+	switch e {
+	case BungieCredentialType_None:
+		return true
+	case BungieCredentialType_Xuid:
+		return true
+	case BungieCredentialType_Psnid:
+		return true
+	case BungieCredentialType_Wlid:
+		return true
+	case BungieCredentialType_Fake:
+		return true
+	case BungieCredentialType_Facebook:
+		return true
+	case BungieCredentialType_Google:
+		return true
+	case BungieCredentialType_Windows:
+		return true
+	case BungieCredentialType_DemonId:
+		return true
+	case BungieCredentialType_SteamId:
+		return true
+	case BungieCredentialType_BattleNetId:
+		return true
+	case BungieCredentialType_StadiaId:
+		return true
+	case BungieCredentialType_TwitchId:
+		return true
+	case BungieCredentialType_EgsId:
+		return true
+	}
+	return false
+}
+
 func (e BungieMembershipType) Enum() string {
 	switch e {
 	case BungieMembershipType_None:
@@ -48877,6 +51520,35 @@ func (e BungieMembershipType) Enum() string {
 	return fmt.Sprintf("BungieMembershipType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e BungieMembershipType) Valid() bool {
+	switch e {
+	case BungieMembershipType_None:
+		return true
+	case BungieMembershipType_TigerXbox:
+		return true
+	case BungieMembershipType_TigerPsn:
+		return true
+	case BungieMembershipType_TigerSteam:
+		return true
+	case BungieMembershipType_TigerBlizzard:
+		return true
+	case BungieMembershipType_TigerStadia:
+		return true
+	case BungieMembershipType_TigerEgs:
+		return true
+	case BungieMembershipType_TigerDemon:
+		return true
+	case BungieMembershipType_GoliathGame:
+		return true
+	case BungieMembershipType_BungieNext:
+		return true
+	case BungieMembershipType_All:
+		return true
+	}
+	return false
+}
+
 func (e ComponentPrivacySetting) Enum() string {
 	switch e {
 	case ComponentPrivacySetting_None:
@@ -48889,6 +51561,19 @@ func (e ComponentPrivacySetting) Enum() string {
 	return fmt.Sprintf("ComponentPrivacySetting_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ComponentPrivacySetting) Valid() bool {
+	switch e {
+	case ComponentPrivacySetting_None:
+		return true
+	case ComponentPrivacySetting_Public:
+		return true
+	case ComponentPrivacySetting_Private:
+		return true
+	}
+	return false
+}
+
 func (e ContentPropertyDataType) Enum() string {
 	switch e {
 	case ContentPropertyDataType_None:
@@ -48925,6 +51610,43 @@ func (e ContentPropertyDataType) Enum() string {
 	return fmt.Sprintf("ContentPropertyDataType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ContentPropertyDataType) Valid() bool {
+	switch e {
+	case ContentPropertyDataType_None:
+		return true
+	case ContentPropertyDataType_Plaintext:
+		return true
+	case ContentPropertyDataType_Html:
+		return true
+	case ContentPropertyDataType_Dropdown:
+		return true
+	case ContentPropertyDataType_List:
+		return true
+	case ContentPropertyDataType_Json:
+		return true
+	case ContentPropertyDataType_Content:
+		return true
+	case ContentPropertyDataType_Representation:
+		return true
+	case ContentPropertyDataType_Set:
+		return true
+	case ContentPropertyDataType_File:
+		return true
+	case ContentPropertyDataType_FolderSet:
+		return true
+	case ContentPropertyDataType_Date:
+		return true
+	case ContentPropertyDataType_MultilinePlaintext:
+		return true
+	case ContentPropertyDataType_DestinyContent:
+		return true
+	case ContentPropertyDataType_Color:
+		return true
+	}
+	return false
+}
+
 func (e ActivityGraphNodeHighlightType) Enum() string {
 	switch e {
 	case ActivityGraphNodeHighlightType_None:
@@ -48941,6 +51663,23 @@ func (e ActivityGraphNodeHighlightType) Enum() string {
 	return fmt.Sprintf("ActivityGraphNodeHighlightType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ActivityGraphNodeHighlightType) Valid() bool {
+	switch e {
+	case ActivityGraphNodeHighlightType_None:
+		return true
+	case ActivityGraphNodeHighlightType_Normal:
+		return true
+	case ActivityGraphNodeHighlightType_Hyper:
+		return true
+	case ActivityGraphNodeHighlightType_Comet:
+		return true
+	case ActivityGraphNodeHighlightType_RiseOfIron:
+		return true
+	}
+	return false
+}
+
 func (e AwaResponseReason) Enum() string {
 	switch e {
 	case AwaResponseReason_None:
@@ -48955,6 +51694,21 @@ func (e AwaResponseReason) Enum() string {
 	return fmt.Sprintf("AwaResponseReason_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e AwaResponseReason) Valid() bool {
+	switch e {
+	case AwaResponseReason_None:
+		return true
+	case AwaResponseReason_Answered:
+		return true
+	case AwaResponseReason_TimedOut:
+		return true
+	case AwaResponseReason_Replaced:
+		return true
+	}
+	return false
+}
+
 func (e AwaType) Enum() string {
 	switch e {
 	case AwaType_None:
@@ -48965,6 +51719,17 @@ func (e AwaType) Enum() string {
 	return fmt.Sprintf("AwaType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e AwaType) Valid() bool {
+	switch e {
+	case AwaType_None:
+		return true
+	case AwaType_InsertPlugs:
+		return true
+	}
+	return false
+}
+
 func (e AwaUserSelection) Enum() string {
 	switch e {
 	case AwaUserSelection_None:
@@ -48977,6 +51742,19 @@ func (e AwaUserSelection) Enum() string {
 	return fmt.Sprintf("AwaUserSelection_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e AwaUserSelection) Valid() bool {
+	switch e {
+	case AwaUserSelection_None:
+		return true
+	case AwaUserSelection_Rejected:
+		return true
+	case AwaUserSelection_Approved:
+		return true
+	}
+	return false
+}
+
 func (e BucketCategory) Enum() string {
 	switch e {
 	case BucketCategory_Invisible:
@@ -48993,6 +51771,23 @@ func (e BucketCategory) Enum() string {
 	return fmt.Sprintf("BucketCategory_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e BucketCategory) Valid() bool {
+	switch e {
+	case BucketCategory_Invisible:
+		return true
+	case BucketCategory_Item:
+		return true
+	case BucketCategory_Currency:
+		return true
+	case BucketCategory_Equippable:
+		return true
+	case BucketCategory_Ignored:
+		return true
+	}
+	return false
+}
+
 func (e BucketScope) Enum() string {
 	switch e {
 	case BucketScope_Character:
@@ -49003,6 +51798,17 @@ func (e BucketScope) Enum() string {
 	return fmt.Sprintf("BucketScope_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e BucketScope) Valid() bool {
+	switch e {
+	case BucketScope_Character:
+		return true
+	case BucketScope_Account:
+		return true
+	}
+	return false
+}
+
 func (e DamageType) Enum() string {
 	switch e {
 	case DamageType_None:
@@ -49024,6 +51830,29 @@ func (e DamageType) Enum() string {
 	}
 	return fmt.Sprintf("DamageType_%d", e)
 }
+
+// Valid reports whether e is one of the values defined by the API.
+func (e DamageType) Valid() bool {
+	switch e {
+	case DamageType_None:
+		return true
+	case DamageType_Kinetic:
+		return true
+	case DamageType_Arc:
+		return true
+	case DamageType_Thermal:
+		return true
+	case DamageType_Void:
+		return true
+	case DamageType_Raid:
+		return true
+	case DamageType_Stasis:
+		return true
+	case DamageType_Strand:
+		return true
+	}
+	return false
+}
 func (d ActivityDifficultyTierCollectionDefinition) DefinitionTable() string {
 	return "DestinyActivityDifficultyTierCollectionDefinition"
 }
@@ -49425,6 +52254,21 @@ func (e RewardSourceCategory) Enum() string {
 	}
 	return fmt.Sprintf("RewardSourceCategory_%d", e)
 }
+
+// Valid reports whether e is one of the values defined by the API.
+func (e RewardSourceCategory) Valid() bool {
+	switch e {
+	case RewardSourceCategory_None:
+		return true
+	case RewardSourceCategory_Activity:
+		return true
+	case RewardSourceCategory_Vendor:
+		return true
+	case RewardSourceCategory_Aggregate:
+		return true
+	}
+	return false
+}
 func (d RewardSourceDefinition) DefinitionTable() string {
 	return "DestinyRewardSourceDefinition"
 }
@@ -49479,6 +52323,25 @@ func (e TalentNodeStepDamageTypes) Enum() string {
 	}
 	return fmt.Sprintf("TalentNodeStepDamageTypes_%d", e)
 }
+
+// Valid reports whether e is one of the values defined by the API.
+func (e TalentNodeStepDamageTypes) Valid() bool {
+	switch e {
+	case TalentNodeStepDamageTypes_None:
+		return true
+	case TalentNodeStepDamageTypes_Kinetic:
+		return true
+	case TalentNodeStepDamageTypes_Arc:
+		return true
+	case TalentNodeStepDamageTypes_Solar:
+		return true
+	case TalentNodeStepDamageTypes_Void:
+		return true
+	case TalentNodeStepDamageTypes_All:
+		return true
+	}
+	return false
+}
 func (d TalentNodeStepGroups) DefinitionTable() string {
 	return "DestinyTalentNodeStepGroups"
 }
@@ -49509,6 +52372,33 @@ func (e TalentNodeStepGuardianAttributes) Enum() string {
 	return fmt.Sprintf("TalentNodeStepGuardianAttributes_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e TalentNodeStepGuardianAttributes) Valid() bool {
+	switch e {
+	case TalentNodeStepGuardianAttributes_None:
+		return true
+	case TalentNodeStepGuardianAttributes_Stats:
+		return true
+	case TalentNodeStepGuardianAttributes_Shields:
+		return true
+	case TalentNodeStepGuardianAttributes_Health:
+		return true
+	case TalentNodeStepGuardianAttributes_Revive:
+		return true
+	case TalentNodeStepGuardianAttributes_AimUnderFire:
+		return true
+	case TalentNodeStepGuardianAttributes_Radar:
+		return true
+	case TalentNodeStepGuardianAttributes_Invisibility:
+		return true
+	case TalentNodeStepGuardianAttributes_Reputations:
+		return true
+	case TalentNodeStepGuardianAttributes_All:
+		return true
+	}
+	return false
+}
+
 func (e TalentNodeStepImpactEffects) Enum() string {
 	switch e {
 	case TalentNodeStepImpactEffects_None:
@@ -49531,6 +52421,29 @@ func (e TalentNodeStepImpactEffects) Enum() string {
 	return fmt.Sprintf("TalentNodeStepImpactEffects_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e TalentNodeStepImpactEffects) Valid() bool {
+	switch e {
+	case TalentNodeStepImpactEffects_None:
+		return true
+	case TalentNodeStepImpactEffects_ArmorPiercing:
+		return true
+	case TalentNodeStepImpactEffects_Ricochet:
+		return true
+	case TalentNodeStepImpactEffects_Flinch:
+		return true
+	case TalentNodeStepImpactEffects_CollateralDamage:
+		return true
+	case TalentNodeStepImpactEffects_Disorient:
+		return true
+	case TalentNodeStepImpactEffects_HighlightTarget:
+		return true
+	case TalentNodeStepImpactEffects_All:
+		return true
+	}
+	return false
+}
+
 func (e TalentNodeStepLightAbilities) Enum() string {
 	switch e {
 	case TalentNodeStepLightAbilities_None:
@@ -49553,6 +52466,29 @@ func (e TalentNodeStepLightAbilities) Enum() string {
 	return fmt.Sprintf("TalentNodeStepLightAbilities_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e TalentNodeStepLightAbilities) Valid() bool {
+	switch e {
+	case TalentNodeStepLightAbilities_None:
+		return true
+	case TalentNodeStepLightAbilities_Grenades:
+		return true
+	case TalentNodeStepLightAbilities_Melee:
+		return true
+	case TalentNodeStepLightAbilities_MovementModes:
+		return true
+	case TalentNodeStepLightAbilities_Orbs:
+		return true
+	case TalentNodeStepLightAbilities_SuperEnergy:
+		return true
+	case TalentNodeStepLightAbilities_SuperMods:
+		return true
+	case TalentNodeStepLightAbilities_All:
+		return true
+	}
+	return false
+}
+
 func (e TalentNodeStepWeaponPerformances) Enum() string {
 	switch e {
 	case TalentNodeStepWeaponPerformances_None:
@@ -49588,6 +52524,43 @@ func (e TalentNodeStepWeaponPerformances) Enum() string {
 	}
 	return fmt.Sprintf("TalentNodeStepWeaponPerformances_%d", e)
 }
+
+// Valid reports whether e is one of the values defined by the API.
+func (e TalentNodeStepWeaponPerformances) Valid() bool {
+	switch e {
+	case TalentNodeStepWeaponPerformances_None:
+		return true
+	case TalentNodeStepWeaponPerformances_RateOfFire:
+		return true
+	case TalentNodeStepWeaponPerformances_Damage:
+		return true
+	case TalentNodeStepWeaponPerformances_Accuracy:
+		return true
+	case TalentNodeStepWeaponPerformances_Range:
+		return true
+	case TalentNodeStepWeaponPerformances_Zoom:
+		return true
+	case TalentNodeStepWeaponPerformances_Recoil:
+		return true
+	case TalentNodeStepWeaponPerformances_Ready:
+		return true
+	case TalentNodeStepWeaponPerformances_Reload:
+		return true
+	case TalentNodeStepWeaponPerformances_HairTrigger:
+		return true
+	case TalentNodeStepWeaponPerformances_AmmoAndMagazine:
+		return true
+	case TalentNodeStepWeaponPerformances_TrackingAndDetonation:
+		return true
+	case TalentNodeStepWeaponPerformances_ShotgunSpread:
+		return true
+	case TalentNodeStepWeaponPerformances_ChargeTime:
+		return true
+	case TalentNodeStepWeaponPerformances_All:
+		return true
+	}
+	return false
+}
 func (d UnlockDefinition) DefinitionTable() string {
 	return "DestinyUnlockDefinition"
 }
@@ -49831,6 +52804,19 @@ func (e MilestoneDisplayPreference) Enum() string {
 	}
 	return fmt.Sprintf("MilestoneDisplayPreference_%d", e)
 }
+
+// Valid reports whether e is one of the values defined by the API.
+func (e MilestoneDisplayPreference) Valid() bool {
+	switch e {
+	case MilestoneDisplayPreference_MilestoneDefinition:
+		return true
+	case MilestoneDisplayPreference_CurrentQuestSteps:
+		return true
+	case MilestoneDisplayPreference_CurrentActivityChallenges:
+		return true
+	}
+	return false
+}
 func (d MilestoneQuestDefinition) DefinitionTable() string {
 	return "DestinyMilestoneQuestDefinition"
 }
@@ -49864,6 +52850,25 @@ func (e MilestoneType) Enum() string {
 	}
 	return fmt.Sprintf("MilestoneType_%d", e)
 }
+
+// Valid reports whether e is one of the values defined by the API.
+func (e MilestoneType) Valid() bool {
+	switch e {
+	case MilestoneType_Unknown:
+		return true
+	case MilestoneType_Tutorial:
+		return true
+	case MilestoneType_OneTime:
+		return true
+	case MilestoneType_Weekly:
+		return true
+	case MilestoneType_Daily:
+		return true
+	case MilestoneType_Special:
+		return true
+	}
+	return false
+}
 func (d MilestoneValueDefinition) DefinitionTable() string {
 	return "DestinyMilestoneValueDefinition"
 }
@@ -50027,6 +53032,31 @@ func (e ActivityDifficultyId) Enum() string {
 	return fmt.Sprintf("ActivityDifficultyId_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ActivityDifficultyId) Valid() bool {
+	switch e {
+	case ActivityDifficultyId_Trivial:
+		return true
+	case ActivityDifficultyId_Easy:
+		return true
+	case ActivityDifficultyId_Normal:
+		return true
+	case ActivityDifficultyId_Challenging:
+		return true
+	case ActivityDifficultyId_Hard:
+		return true
+	case ActivityDifficultyId_Brave:
+		return true
+	case ActivityDifficultyId_AlmostImpossible:
+		return true
+	case ActivityDifficultyId_Impossible:
+		return true
+	case ActivityDifficultyId_Count:
+		return true
+	}
+	return false
+}
+
 func (e ActivityDifficultyTier) Enum() string {
 	switch e {
 	case ActivityDifficultyTier_Trivial:
@@ -50049,6 +53079,29 @@ func (e ActivityDifficultyTier) Enum() string {
 	return fmt.Sprintf("ActivityDifficultyTier_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ActivityDifficultyTier) Valid() bool {
+	switch e {
+	case ActivityDifficultyTier_Trivial:
+		return true
+	case ActivityDifficultyTier_Easy:
+		return true
+	case ActivityDifficultyTier_Normal:
+		return true
+	case ActivityDifficultyTier_Challenging:
+		return true
+	case ActivityDifficultyTier_Hard:
+		return true
+	case ActivityDifficultyTier_Brave:
+		return true
+	case ActivityDifficultyTier_AlmostImpossible:
+		return true
+	case ActivityDifficultyTier_Impossible:
+		return true
+	}
+	return false
+}
+
 func (e ActivityDifficultyTierType) Enum() string {
 	switch e {
 	case ActivityDifficultyTierType_Default:
@@ -50061,6 +53114,19 @@ func (e ActivityDifficultyTierType) Enum() string {
 	return fmt.Sprintf("ActivityDifficultyTierType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ActivityDifficultyTierType) Valid() bool {
+	switch e {
+	case ActivityDifficultyTierType_Default:
+		return true
+	case ActivityDifficultyTierType_Training:
+		return true
+	case ActivityDifficultyTierType_Count:
+		return true
+	}
+	return false
+}
+
 func (e ActivityModeCategory) Enum() string {
 	switch e {
 	case ActivityModeCategory_None:
@@ -50075,6 +53141,21 @@ func (e ActivityModeCategory) Enum() string {
 	return fmt.Sprintf("ActivityModeCategory_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ActivityModeCategory) Valid() bool {
+	switch e {
+	case ActivityModeCategory_None:
+		return true
+	case ActivityModeCategory_PvE:
+		return true
+	case ActivityModeCategory_PvP:
+		return true
+	case ActivityModeCategory_PvECompetitive:
+		return true
+	}
+	return false
+}
+
 func (e ActivityModifierConnotation) Enum() string {
 	switch e {
 	case ActivityModifierConnotation_Neutral:
@@ -50097,6 +53178,29 @@ func (e ActivityModifierConnotation) Enum() string {
 	return fmt.Sprintf("ActivityModifierConnotation_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ActivityModifierConnotation) Valid() bool {
+	switch e {
+	case ActivityModifierConnotation_Neutral:
+		return true
+	case ActivityModifierConnotation_Positive:
+		return true
+	case ActivityModifierConnotation_Negative:
+		return true
+	case ActivityModifierConnotation_Affix:
+		return true
+	case ActivityModifierConnotation_Informational:
+		return true
+	case ActivityModifierConnotation_Reward:
+		return true
+	case ActivityModifierConnotation_Event:
+		return true
+	case ActivityModifierConnotation_Count:
+		return true
+	}
+	return false
+}
+
 func (e ActivityModifierDisplayCategory) Enum() string {
 	switch e {
 	case ActivityModifierDisplayCategory_None:
@@ -50119,6 +53223,29 @@ func (e ActivityModifierDisplayCategory) Enum() string {
 	return fmt.Sprintf("ActivityModifierDisplayCategory_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ActivityModifierDisplayCategory) Valid() bool {
+	switch e {
+	case ActivityModifierDisplayCategory_None:
+		return true
+	case ActivityModifierDisplayCategory_ModeRules:
+		return true
+	case ActivityModifierDisplayCategory_SelfBuildcraft:
+		return true
+	case ActivityModifierDisplayCategory_EnemyAdjustment:
+		return true
+	case ActivityModifierDisplayCategory_EnemyBuildcraft:
+		return true
+	case ActivityModifierDisplayCategory_Seasonal:
+		return true
+	case ActivityModifierDisplayCategory_Fun:
+		return true
+	case ActivityModifierDisplayCategory_Count:
+		return true
+	}
+	return false
+}
+
 func (e ActivityNavPointType) Enum() string {
 	switch e {
 	case ActivityNavPointType_Inactive:
@@ -50159,6 +53286,47 @@ func (e ActivityNavPointType) Enum() string {
 	return fmt.Sprintf("ActivityNavPointType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ActivityNavPointType) Valid() bool {
+	switch e {
+	case ActivityNavPointType_Inactive:
+		return true
+	case ActivityNavPointType_PrimaryObjective:
+		return true
+	case ActivityNavPointType_SecondaryObjective:
+		return true
+	case ActivityNavPointType_TravelObjective:
+		return true
+	case ActivityNavPointType_PublicEventObjective:
+		return true
+	case ActivityNavPointType_AmmoCache:
+		return true
+	case ActivityNavPointType_PointTypeFlag:
+		return true
+	case ActivityNavPointType_CapturePoint:
+		return true
+	case ActivityNavPointType_DefensiveEncounter:
+		return true
+	case ActivityNavPointType_GhostInteraction:
+		return true
+	case ActivityNavPointType_KillAi:
+		return true
+	case ActivityNavPointType_QuestItem:
+		return true
+	case ActivityNavPointType_PatrolMission:
+		return true
+	case ActivityNavPointType_Incoming:
+		return true
+	case ActivityNavPointType_ArenaObjective:
+		return true
+	case ActivityNavPointType_AutomationHint:
+		return true
+	case ActivityNavPointType_TrackedQuest:
+		return true
+	}
+	return false
+}
+
 func (e ActivityRewardDisplayMode) Enum() string {
 	switch e {
 	case ActivityRewardDisplayMode_Aggregate:
@@ -50171,6 +53339,19 @@ func (e ActivityRewardDisplayMode) Enum() string {
 	return fmt.Sprintf("ActivityRewardDisplayMode_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ActivityRewardDisplayMode) Valid() bool {
+	switch e {
+	case ActivityRewardDisplayMode_Aggregate:
+		return true
+	case ActivityRewardDisplayMode_PickFirst:
+		return true
+	case ActivityRewardDisplayMode_Count:
+		return true
+	}
+	return false
+}
+
 func (e ActivitySkullDynamicUse) Enum() string {
 	switch e {
 	case ActivitySkullDynamicUse_Unknown:
@@ -50185,6 +53366,21 @@ func (e ActivitySkullDynamicUse) Enum() string {
 	return fmt.Sprintf("ActivitySkullDynamicUse_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ActivitySkullDynamicUse) Valid() bool {
+	switch e {
+	case ActivitySkullDynamicUse_Unknown:
+		return true
+	case ActivitySkullDynamicUse_Allowed:
+		return true
+	case ActivitySkullDynamicUse_Disallowed:
+		return true
+	case ActivitySkullDynamicUse_Count:
+		return true
+	}
+	return false
+}
+
 func (e ActivityTreeChildSortMode) Enum() string {
 	switch e {
 	case ActivityTreeChildSortMode_Investment:
@@ -50197,6 +53393,19 @@ func (e ActivityTreeChildSortMode) Enum() string {
 	return fmt.Sprintf("ActivityTreeChildSortMode_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ActivityTreeChildSortMode) Valid() bool {
+	switch e {
+	case ActivityTreeChildSortMode_Investment:
+		return true
+	case ActivityTreeChildSortMode_FocusFirst:
+		return true
+	case ActivityTreeChildSortMode_BonusAndFocusFirst:
+		return true
+	}
+	return false
+}
+
 func (e ActivityTreeType) Enum() string {
 	switch e {
 	case ActivityTreeType_FireteamFinder:
@@ -50213,6 +53422,23 @@ func (e ActivityTreeType) Enum() string {
 	return fmt.Sprintf("ActivityTreeType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ActivityTreeType) Valid() bool {
+	switch e {
+	case ActivityTreeType_FireteamFinder:
+		return true
+	case ActivityTreeType_Curator:
+		return true
+	case ActivityTreeType_EventHome:
+		return true
+	case ActivityTreeType_SeasonHome:
+		return true
+	case ActivityTreeType_Count:
+		return true
+	}
+	return false
+}
+
 func (e AmmunitionType) Enum() string {
 	switch e {
 	case AmmunitionType_None:
@@ -50229,6 +53455,23 @@ func (e AmmunitionType) Enum() string {
 	return fmt.Sprintf("AmmunitionType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e AmmunitionType) Valid() bool {
+	switch e {
+	case AmmunitionType_None:
+		return true
+	case AmmunitionType_Primary:
+		return true
+	case AmmunitionType_Special:
+		return true
+	case AmmunitionType_Heavy:
+		return true
+	case AmmunitionType_Unknown:
+		return true
+	}
+	return false
+}
+
 func (e BreakerType) Enum() string {
 	switch e {
 	case BreakerType_None:
@@ -50243,6 +53486,21 @@ func (e BreakerType) Enum() string {
 	return fmt.Sprintf("BreakerType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e BreakerType) Valid() bool {
+	switch e {
+	case BreakerType_None:
+		return true
+	case BreakerType_ShieldPiercing:
+		return true
+	case BreakerType_Disruption:
+		return true
+	case BreakerType_Stagger:
+		return true
+	}
+	return false
+}
+
 func (e Class) Enum() string {
 	switch e {
 	case Class_Titan:
@@ -50257,6 +53515,21 @@ func (e Class) Enum() string {
 	return fmt.Sprintf("Class_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e Class) Valid() bool {
+	switch e {
+	case Class_Titan:
+		return true
+	case Class_Hunter:
+		return true
+	case Class_Warlock:
+		return true
+	case Class_Unknown:
+		return true
+	}
+	return false
+}
+
 func (e CollectibleState) Enum() string {
 	switch e {
 	case CollectibleState_None:
@@ -50279,6 +53552,29 @@ func (e CollectibleState) Enum() string {
 	return fmt.Sprintf("CollectibleState_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e CollectibleState) Valid() bool {
+	switch e {
+	case CollectibleState_None:
+		return true
+	case CollectibleState_NotAcquired:
+		return true
+	case CollectibleState_Obscured:
+		return true
+	case CollectibleState_Invisible:
+		return true
+	case CollectibleState_CannotAffordMaterialRequirements:
+		return true
+	case CollectibleState_InventorySpaceUnavailable:
+		return true
+	case CollectibleState_UniquenessViolation:
+		return true
+	case CollectibleState_PurchaseDisabled:
+		return true
+	}
+	return false
+}
+
 func (e ComponentType) Enum() string {
 	switch e {
 	case ComponentType_None:
@@ -50361,6 +53657,419 @@ func (e ComponentType) Enum() string {
 	return fmt.Sprintf("ComponentType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ComponentType) Valid() bool {
+	switch e {
+	case ComponentType_None:
+		return true
+	case ComponentType_Profiles:
+		return true
+	case ComponentType_VendorReceipts:
+		return true
+	case ComponentType_ProfileInventories:
+		return true
+	case ComponentType_ProfileCurrencies:
+		return true
+	case ComponentType_ProfileProgression:
+		return true
+	case ComponentType_PlatformSilver:
+		return true
+	case ComponentType_Characters:
+		return true
+	case ComponentType_CharacterInventories:
+		return true
+	case ComponentType_CharacterProgressions:
+		return true
+	case ComponentType_CharacterRenderData:
+		return true
+	case ComponentType_CharacterActivities:
+		return true
+	case ComponentType_CharacterEquipment:
+		return true
+	case ComponentType_CharacterLoadouts:
+		return true
+	case ComponentType_ItemInstances:
+		return true
+	case ComponentType_ItemObjectives:
+		return true
+	case ComponentType_ItemPerks:
+		return true
+	case ComponentType_ItemRenderData:
+		return true
+	case ComponentType_ItemStats:
+		return true
+	case ComponentType_ItemSockets:
+		return true
+	case ComponentType_ItemTalentGrids:
+		return true
+	case ComponentType_ItemCommonData:
+		return true
+	case ComponentType_ItemPlugStates:
+		return true
+	case ComponentType_ItemPlugObjectives:
+		return true
+	case ComponentType_ItemReusablePlugs:
+		return true
+	case ComponentType_Vendors:
+		return true
+	case ComponentType_VendorCategories:
+		return true
+	case ComponentType_VendorSales:
+		return true
+	case ComponentType_Kiosks:
+		return true
+	case ComponentType_CurrencyLookups:
+		return true
+	case ComponentType_PresentationNodes:
+		return true
+	case ComponentType_Collectibles:
+		return true
+	case ComponentType_Records:
+		return true
+	case ComponentType_Transitory:
+		return true
+	case ComponentType_Metrics:
+		return true
+	case ComponentType_StringVariables:
+		return true
+	case ComponentType_Craftables:
+		return true
+	case ComponentType_SocialCommendations:
+		return true
+	}
+	return false
+}
+
+// Profiles adds ComponentType_Profiles.
+//
+// Profiles is the most basic component, only relevant when calling GetProfile. This returns basic
+// information about the profile, which is almost nothing: a list of characterIds, some information
+// about the last time you logged in, and that most sobering statistic: how long you've played.
+func (c ComponentSet) Profiles() ComponentSet {
+	return c.With(ComponentType_Profiles)
+}
+
+// VendorReceipts adds ComponentType_VendorReceipts.
+//
+// Only applicable for GetProfile, this will return information about receipts for refundable vendor
+// items.
+func (c ComponentSet) VendorReceipts() ComponentSet {
+	return c.With(ComponentType_VendorReceipts)
+}
+
+// ProfileInventories adds ComponentType_ProfileInventories.
+//
+// Asking for this will get you the profile-level inventories, such as your Vault buckets (yeah, the
+// Vault is really inventory buckets located on your Profile)
+func (c ComponentSet) ProfileInventories() ComponentSet {
+	return c.With(ComponentType_ProfileInventories)
+}
+
+// ProfileCurrencies adds ComponentType_ProfileCurrencies.
+//
+// This will get you a summary of items on your Profile that we consider to be "currencies", such as
+// Glimmer. I mean, if there's Glimmer in Destiny 2. I didn't say there was Glimmer.
+func (c ComponentSet) ProfileCurrencies() ComponentSet {
+	return c.With(ComponentType_ProfileCurrencies)
+}
+
+// ProfileProgression adds ComponentType_ProfileProgression.
+//
+// This will get you any progression-related information that exists on a Profile-wide level, across
+// all characters.
+func (c ComponentSet) ProfileProgression() ComponentSet {
+	return c.With(ComponentType_ProfileProgression)
+}
+
+// PlatformSilver adds ComponentType_PlatformSilver.
+//
+// This will get you information about the silver that this profile has on every platform on which it
+// plays.
+//
+//	You may only request this component for the logged in user's Profile, and will not recieve it if
+//
+// you request it for another Profile.
+func (c ComponentSet) PlatformSilver() ComponentSet {
+	return c.With(ComponentType_PlatformSilver)
+}
+
+// Characters adds ComponentType_Characters.
+//
+// This will get you summary info about each of the characters in the profile.
+func (c ComponentSet) Characters() ComponentSet {
+	return c.With(ComponentType_Characters)
+}
+
+// CharacterInventories adds ComponentType_CharacterInventories.
+//
+// This will get you information about any non-equipped items on the character or character(s) in
+// question, if you're allowed to see it. You have to either be authenticated as that user, or that
+// user must allow anonymous viewing of their non-equipped items in Bungie.Net settings to actually get
+// results.
+func (c ComponentSet) CharacterInventories() ComponentSet {
+	return c.With(ComponentType_CharacterInventories)
+}
+
+// CharacterProgressions adds ComponentType_CharacterProgressions.
+//
+// This will get you information about the progression (faction, experience, etc... "levels") relevant
+// to each character, if you are the currently authenticated user or the user has elected to allow
+// anonymous viewing of its progression info.
+func (c ComponentSet) CharacterProgressions() ComponentSet {
+	return c.With(ComponentType_CharacterProgressions)
+}
+
+// CharacterRenderData adds ComponentType_CharacterRenderData.
+//
+// This will get you just enough information to be able to render the character in 3D if you have
+// written a 3D rendering library for Destiny Characters, or "borrowed" ours. It's okay, I won't tell
+// anyone if you're using it. I'm no snitch. (actually, we don't care if you use it - go to town)
+func (c ComponentSet) CharacterRenderData() ComponentSet {
+	return c.With(ComponentType_CharacterRenderData)
+}
+
+// CharacterActivities adds ComponentType_CharacterActivities.
+//
+// This will return info about activities that a user can see and gating on it, if you are the
+// currently authenticated user or the user has elected to allow anonymous viewing of its progression
+// info. Note that the data returned by this can be unfortunately problematic and relatively unreliable
+// in some cases. We'll eventually work on making it more consistently reliable.
+func (c ComponentSet) CharacterActivities() ComponentSet {
+	return c.With(ComponentType_CharacterActivities)
+}
+
+// CharacterEquipment adds ComponentType_CharacterEquipment.
+//
+// This will return info about the equipped items on the character(s). Everyone can see this.
+func (c ComponentSet) CharacterEquipment() ComponentSet {
+	return c.With(ComponentType_CharacterEquipment)
+}
+
+// CharacterLoadouts adds ComponentType_CharacterLoadouts.
+//
+// This will return info about the loadouts of the character(s).
+func (c ComponentSet) CharacterLoadouts() ComponentSet {
+	return c.With(ComponentType_CharacterLoadouts)
+}
+
+// ItemInstances adds ComponentType_ItemInstances.
+//
+// This will return basic info about instanced items - whether they can be equipped, their tracked
+// status, and some info commonly needed in many places (current damage type, primary stat value, etc)
+func (c ComponentSet) ItemInstances() ComponentSet {
+	return c.With(ComponentType_ItemInstances)
+}
+
+// ItemObjectives adds ComponentType_ItemObjectives.
+//
+// Items can have Objectives (DestinyObjectiveDefinition) bound to them. If they do, this will return
+// info for items that have such bound objectives.
+func (c ComponentSet) ItemObjectives() ComponentSet {
+	return c.With(ComponentType_ItemObjectives)
+}
+
+// ItemPerks adds ComponentType_ItemPerks.
+//
+// Items can have perks (DestinySandboxPerkDefinition). If they do, this will return info for what
+// perks are active on items.
+func (c ComponentSet) ItemPerks() ComponentSet {
+	return c.With(ComponentType_ItemPerks)
+}
+
+// ItemRenderData adds ComponentType_ItemRenderData.
+//
+// If you just want to render the weapon, this is just enough info to do that rendering.
+func (c ComponentSet) ItemRenderData() ComponentSet {
+	return c.With(ComponentType_ItemRenderData)
+}
+
+// ItemStats adds ComponentType_ItemStats.
+//
+// Items can have stats, like rate of fire. Asking for this component will return requested item's
+// stats if they have stats.
+func (c ComponentSet) ItemStats() ComponentSet {
+	return c.With(ComponentType_ItemStats)
+}
+
+// ItemSockets adds ComponentType_ItemSockets.
+//
+// Items can have sockets, where plugs can be inserted. Asking for this component will return all info
+// relevant to the sockets on items that have them.
+func (c ComponentSet) ItemSockets() ComponentSet {
+	return c.With(ComponentType_ItemSockets)
+}
+
+// ItemTalentGrids adds ComponentType_ItemTalentGrids.
+//
+// Items can have talent grids, though that matters a lot less frequently than it used to. Asking for
+// this component will return all relevant info about activated Nodes and Steps on this talent grid,
+// like the good ol' days.
+func (c ComponentSet) ItemTalentGrids() ComponentSet {
+	return c.With(ComponentType_ItemTalentGrids)
+}
+
+// ItemCommonData adds ComponentType_ItemCommonData.
+//
+// Items that *aren't* instanced still have important information you need to know: how much of it you
+// have, the itemHash so you can look up their DestinyInventoryItemDefinition, whether they're locked,
+// etc... Both instanced and non-instanced items will have these properties. You will get this
+// automatically with Inventory components - you only need to pass this when calling GetItem on a
+// specific item.
+func (c ComponentSet) ItemCommonData() ComponentSet {
+	return c.With(ComponentType_ItemCommonData)
+}
+
+// ItemPlugStates adds ComponentType_ItemPlugStates.
+//
+// Items that are "Plugs" can be inserted into sockets. This returns statuses about those plugs and why
+// they can/can't be inserted. I hear you giggling, there's nothing funny about inserting plugs. Get
+// your head out of the gutter and pay attention!
+func (c ComponentSet) ItemPlugStates() ComponentSet {
+	return c.With(ComponentType_ItemPlugStates)
+}
+
+// ItemPlugObjectives adds ComponentType_ItemPlugObjectives.
+//
+// Sometimes, plugs have objectives on them. This data can get really large, so we split it into its
+// own component. Please, don't grab it unless you need it.
+func (c ComponentSet) ItemPlugObjectives() ComponentSet {
+	return c.With(ComponentType_ItemPlugObjectives)
+}
+
+// ItemReusablePlugs adds ComponentType_ItemReusablePlugs.
+//
+// Sometimes, designers create thousands of reusable plugs and suddenly your response sizes are almost
+// 3MB, and something has to give.
+//
+//	Reusable Plugs were split off as their own component, away from ItemSockets, as a result of the
+//
+// Plug changes in Shadowkeep that made plug data infeasibly large for the most common use cases.
+//
+//	Request this component if and only if you need to know what plugs *could* be inserted into a
+//
+// socket, and need to know it before "drilling" into the details of an item in your application (for
+// instance, if you're doing some sort of interesting sorting or aggregation based on available plugs.
+//
+//	When you get this, you will also need to combine it with "Plug Sets" data if you want a full
+//
+// picture of all of the available plugs: this component will only return plugs that have state data
+// that is per-item. See Plug Sets for available plugs that have Character, Profile, or no
+// state-specific restrictions.
+func (c ComponentSet) ItemReusablePlugs() ComponentSet {
+	return c.With(ComponentType_ItemReusablePlugs)
+}
+
+// Vendors adds ComponentType_Vendors.
+//
+// When obtaining vendor information, this will return summary information about the Vendor or Vendors
+// being returned.
+func (c ComponentSet) Vendors() ComponentSet {
+	return c.With(ComponentType_Vendors)
+}
+
+// VendorCategories adds ComponentType_VendorCategories.
+//
+// When obtaining vendor information, this will return information about the categories of items
+// provided by the Vendor.
+func (c ComponentSet) VendorCategories() ComponentSet {
+	return c.With(ComponentType_VendorCategories)
+}
+
+// VendorSales adds ComponentType_VendorSales.
+//
+// When obtaining vendor information, this will return the information about items being sold by the
+// Vendor.
+func (c ComponentSet) VendorSales() ComponentSet {
+	return c.With(ComponentType_VendorSales)
+}
+
+// Kiosks adds ComponentType_Kiosks.
+//
+// Asking for this component will return you the account's Kiosk statuses: that is, what items have
+// been filled out/acquired. But only if you are the currently authenticated user or the user has
+// elected to allow anonymous viewing of its progression info.
+func (c ComponentSet) Kiosks() ComponentSet {
+	return c.With(ComponentType_Kiosks)
+}
+
+// CurrencyLookups adds ComponentType_CurrencyLookups.
+//
+// A "shortcut" component that will give you all of the item hashes/quantities of items that the
+// requested character can use to determine if an action (purchasing, socket insertion) has the
+// required currency. (recall that all currencies are just items, and that some vendor purchases
+// require items that you might not traditionally consider to be a "currency", like plugs/mods!)
+func (c ComponentSet) CurrencyLookups() ComponentSet {
+	return c.With(ComponentType_CurrencyLookups)
+}
+
+// PresentationNodes adds ComponentType_PresentationNodes.
+//
+// Returns summary status information about all "Presentation Nodes". See
+// DestinyPresentationNodeDefinition for more details, but the gist is that these are entities used by
+// the game UI to bucket Collectibles and Records into a hierarchy of categories. You may ask for and
+// use this data if you want to perform similar bucketing in your own UI: or you can skip it and roll
+// your own.
+func (c ComponentSet) PresentationNodes() ComponentSet {
+	return c.With(ComponentType_PresentationNodes)
+}
+
+// Collectibles adds ComponentType_Collectibles.
+//
+// Returns summary status information about all "Collectibles". These are records of what items you've
+// discovered while playing Destiny, and some other basic information. For detailed information, you
+// will have to call a separate endpoint devoted to the purpose.
+func (c ComponentSet) Collectibles() ComponentSet {
+	return c.With(ComponentType_Collectibles)
+}
+
+// Records adds ComponentType_Records.
+//
+// Returns summary status information about all "Records" (also known in the game as "Triumphs". I
+// know, it's confusing because there's also "Moments of Triumph" that will themselves be represented
+// as "Triumphs.")
+func (c ComponentSet) Records() ComponentSet {
+	return c.With(ComponentType_Records)
+}
+
+// Transitory adds ComponentType_Transitory.
+//
+// Returns information that Bungie considers to be "Transitory": data that may change too frequently or
+// come from a non-authoritative source such that we don't consider the data to be fully trustworthy,
+// but that might prove useful for some limited use cases. We can provide no guarantee of timeliness
+// nor consistency for this data: buyer beware with the Transitory component.
+func (c ComponentSet) Transitory() ComponentSet {
+	return c.With(ComponentType_Transitory)
+}
+
+// Metrics adds ComponentType_Metrics.
+//
+// Returns summary status information about all "Metrics" (also known in the game as "Stat Trackers").
+func (c ComponentSet) Metrics() ComponentSet {
+	return c.With(ComponentType_Metrics)
+}
+
+// StringVariables adds ComponentType_StringVariables.
+//
+// Returns a mapping of localized string variable hashes to values, on a per-account or per-character
+// basis.
+func (c ComponentSet) StringVariables() ComponentSet {
+	return c.With(ComponentType_StringVariables)
+}
+
+// Craftables adds ComponentType_Craftables.
+//
+// Returns summary status information about all "Craftables" aka crafting recipe items.
+func (c ComponentSet) Craftables() ComponentSet {
+	return c.With(ComponentType_Craftables)
+}
+
+// SocialCommendations adds ComponentType_SocialCommendations.
+//
+// Returns score values for all commendations and commendation nodes.
+func (c ComponentSet) SocialCommendations() ComponentSet {
+	return c.With(ComponentType_SocialCommendations)
+}
+
 func (e EnergyType) Enum() string {
 	switch e {
 	case EnergyType_Any:
@@ -50381,6 +54090,27 @@ func (e EnergyType) Enum() string {
 	return fmt.Sprintf("EnergyType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e EnergyType) Valid() bool {
+	switch e {
+	case EnergyType_Any:
+		return true
+	case EnergyType_Arc:
+		return true
+	case EnergyType_Thermal:
+		return true
+	case EnergyType_Void:
+		return true
+	case EnergyType_Ghost:
+		return true
+	case EnergyType_Subclass:
+		return true
+	case EnergyType_Stasis:
+		return true
+	}
+	return false
+}
+
 func (e GamePrivacySetting) Enum() string {
 	switch e {
 	case GamePrivacySetting_Open:
@@ -50397,6 +54127,23 @@ func (e GamePrivacySetting) Enum() string {
 	return fmt.Sprintf("GamePrivacySetting_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e GamePrivacySetting) Valid() bool {
+	switch e {
+	case GamePrivacySetting_Open:
+		return true
+	case GamePrivacySetting_ClanAndFriendsOnly:
+		return true
+	case GamePrivacySetting_FriendsOnly:
+		return true
+	case GamePrivacySetting_InvitationOnly:
+		return true
+	case GamePrivacySetting_Closed:
+		return true
+	}
+	return false
+}
+
 func (e GameVersions) Enum() string {
 	switch e {
 	case GameVersions_None:
@@ -50431,6 +54178,41 @@ func (e GameVersions) Enum() string {
 	return fmt.Sprintf("GameVersions_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e GameVersions) Valid() bool {
+	switch e {
+	case GameVersions_None:
+		return true
+	case GameVersions_Destiny2:
+		return true
+	case GameVersions_DLC1:
+		return true
+	case GameVersions_DLC2:
+		return true
+	case GameVersions_Forsaken:
+		return true
+	case GameVersions_YearTwoAnnualPass:
+		return true
+	case GameVersions_Shadowkeep:
+		return true
+	case GameVersions_BeyondLight:
+		return true
+	case GameVersions_Anniversary30th:
+		return true
+	case GameVersions_TheWitchQueen:
+		return true
+	case GameVersions_Lightfall:
+		return true
+	case GameVersions_TheFinalShape:
+		return true
+	case GameVersions_EdgeOfFate:
+		return true
+	case GameVersions_Renegades:
+		return true
+	}
+	return false
+}
+
 func (e GatingScope) Enum() string {
 	switch e {
 	case GatingScope_None:
@@ -50451,6 +54233,27 @@ func (e GatingScope) Enum() string {
 	return fmt.Sprintf("GatingScope_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e GatingScope) Valid() bool {
+	switch e {
+	case GatingScope_None:
+		return true
+	case GatingScope_Global:
+		return true
+	case GatingScope_Clan:
+		return true
+	case GatingScope_Profile:
+		return true
+	case GatingScope_Character:
+		return true
+	case GatingScope_Item:
+		return true
+	case GatingScope_AssumedWorstCase:
+		return true
+	}
+	return false
+}
+
 func (e Gender) Enum() string {
 	switch e {
 	case Gender_Male:
@@ -50463,6 +54266,19 @@ func (e Gender) Enum() string {
 	return fmt.Sprintf("Gender_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e Gender) Valid() bool {
+	switch e {
+	case Gender_Male:
+		return true
+	case Gender_Female:
+		return true
+	case Gender_Unknown:
+		return true
+	}
+	return false
+}
+
 func (e GraphNodeState) Enum() string {
 	switch e {
 	case GraphNodeState_Hidden:
@@ -50479,6 +54295,23 @@ func (e GraphNodeState) Enum() string {
 	return fmt.Sprintf("GraphNodeState_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e GraphNodeState) Valid() bool {
+	switch e {
+	case GraphNodeState_Hidden:
+		return true
+	case GraphNodeState_Visible:
+		return true
+	case GraphNodeState_Teaser:
+		return true
+	case GraphNodeState_Incomplete:
+		return true
+	case GraphNodeState_Completed:
+		return true
+	}
+	return false
+}
+
 func (e ItemSortType) Enum() string {
 	switch e {
 	case ItemSortType_ItemId:
@@ -50491,6 +54324,19 @@ func (e ItemSortType) Enum() string {
 	return fmt.Sprintf("ItemSortType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ItemSortType) Valid() bool {
+	switch e {
+	case ItemSortType_ItemId:
+		return true
+	case ItemSortType_Timestamp:
+		return true
+	case ItemSortType_StackSize:
+		return true
+	}
+	return false
+}
+
 func (e ItemSubType) Enum() string {
 	switch e {
 	case ItemSubType_None:
@@ -50559,6 +54405,75 @@ func (e ItemSubType) Enum() string {
 	return fmt.Sprintf("ItemSubType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ItemSubType) Valid() bool {
+	switch e {
+	case ItemSubType_None:
+		return true
+	case ItemSubType_Crucible:
+		return true
+	case ItemSubType_Vanguard:
+		return true
+	case ItemSubType_Exotic:
+		return true
+	case ItemSubType_AutoRifle:
+		return true
+	case ItemSubType_Shotgun:
+		return true
+	case ItemSubType_Machinegun:
+		return true
+	case ItemSubType_HandCannon:
+		return true
+	case ItemSubType_RocketLauncher:
+		return true
+	case ItemSubType_FusionRifle:
+		return true
+	case ItemSubType_SniperRifle:
+		return true
+	case ItemSubType_PulseRifle:
+		return true
+	case ItemSubType_ScoutRifle:
+		return true
+	case ItemSubType_Crm:
+		return true
+	case ItemSubType_Sidearm:
+		return true
+	case ItemSubType_Sword:
+		return true
+	case ItemSubType_Mask:
+		return true
+	case ItemSubType_Shader:
+		return true
+	case ItemSubType_Ornament:
+		return true
+	case ItemSubType_FusionRifleLine:
+		return true
+	case ItemSubType_GrenadeLauncher:
+		return true
+	case ItemSubType_SubmachineGun:
+		return true
+	case ItemSubType_TraceRifle:
+		return true
+	case ItemSubType_HelmetArmor:
+		return true
+	case ItemSubType_GauntletsArmor:
+		return true
+	case ItemSubType_ChestArmor:
+		return true
+	case ItemSubType_LegArmor:
+		return true
+	case ItemSubType_ClassArmor:
+		return true
+	case ItemSubType_Bow:
+		return true
+	case ItemSubType_DummyRepeatableBounty:
+		return true
+	case ItemSubType_Glaive:
+		return true
+	}
+	return false
+}
+
 func (e ItemType) Enum() string {
 	switch e {
 	case ItemType_None:
@@ -50621,6 +54536,69 @@ func (e ItemType) Enum() string {
 	return fmt.Sprintf("ItemType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ItemType) Valid() bool {
+	switch e {
+	case ItemType_None:
+		return true
+	case ItemType_Currency:
+		return true
+	case ItemType_Armor:
+		return true
+	case ItemType_Weapon:
+		return true
+	case ItemType_Message:
+		return true
+	case ItemType_Engram:
+		return true
+	case ItemType_Consumable:
+		return true
+	case ItemType_ExchangeMaterial:
+		return true
+	case ItemType_MissionReward:
+		return true
+	case ItemType_QuestStep:
+		return true
+	case ItemType_QuestStepComplete:
+		return true
+	case ItemType_Emblem:
+		return true
+	case ItemType_Quest:
+		return true
+	case ItemType_Subclass:
+		return true
+	case ItemType_ClanBanner:
+		return true
+	case ItemType_Aura:
+		return true
+	case ItemType_Mod:
+		return true
+	case ItemType_Dummy:
+		return true
+	case ItemType_Ship:
+		return true
+	case ItemType_Vehicle:
+		return true
+	case ItemType_Emote:
+		return true
+	case ItemType_Ghost:
+		return true
+	case ItemType_Package:
+		return true
+	case ItemType_Bounty:
+		return true
+	case ItemType_Wrapper:
+		return true
+	case ItemType_SeasonalArtifact:
+		return true
+	case ItemType_Finisher:
+		return true
+	case ItemType_Pattern:
+		return true
+	}
+	return false
+}
+
 func (e JoinClosedReasons) Enum() string {
 	switch e {
 	case JoinClosedReasons_None:
@@ -50641,6 +54619,27 @@ func (e JoinClosedReasons) Enum() string {
 	return fmt.Sprintf("JoinClosedReasons_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e JoinClosedReasons) Valid() bool {
+	switch e {
+	case JoinClosedReasons_None:
+		return true
+	case JoinClosedReasons_InMatchmaking:
+		return true
+	case JoinClosedReasons_Loading:
+		return true
+	case JoinClosedReasons_SoloMode:
+		return true
+	case JoinClosedReasons_InternalReasons:
+		return true
+	case JoinClosedReasons_DisallowedByGameState:
+		return true
+	case JoinClosedReasons_Offline:
+		return true
+	}
+	return false
+}
+
 func (e ObjectiveGrantStyle) Enum() string {
 	switch e {
 	case ObjectiveGrantStyle_WhenIncomplete:
@@ -50653,6 +54652,19 @@ func (e ObjectiveGrantStyle) Enum() string {
 	return fmt.Sprintf("ObjectiveGrantStyle_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ObjectiveGrantStyle) Valid() bool {
+	switch e {
+	case ObjectiveGrantStyle_WhenIncomplete:
+		return true
+	case ObjectiveGrantStyle_WhenComplete:
+		return true
+	case ObjectiveGrantStyle_Always:
+		return true
+	}
+	return false
+}
+
 func (e ObjectiveUiStyle) Enum() string {
 	switch e {
 	case ObjectiveUiStyle_None:
@@ -50685,6 +54697,39 @@ func (e ObjectiveUiStyle) Enum() string {
 	return fmt.Sprintf("ObjectiveUiStyle_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ObjectiveUiStyle) Valid() bool {
+	switch e {
+	case ObjectiveUiStyle_None:
+		return true
+	case ObjectiveUiStyle_Highlighted:
+		return true
+	case ObjectiveUiStyle_CraftingWeaponLevel:
+		return true
+	case ObjectiveUiStyle_CraftingWeaponLevelProgress:
+		return true
+	case ObjectiveUiStyle_CraftingWeaponTimestamp:
+		return true
+	case ObjectiveUiStyle_CraftingMementos:
+		return true
+	case ObjectiveUiStyle_CraftingMementoTitle:
+		return true
+	case ObjectiveUiStyle_DiscoverableMystery0:
+		return true
+	case ObjectiveUiStyle_DiscoverableMystery1:
+		return true
+	case ObjectiveUiStyle_DiscoverableMystery2:
+		return true
+	case ObjectiveUiStyle_DiscoverableMystery3:
+		return true
+	case ObjectiveUiStyle_DiscoverableMystery4:
+		return true
+	case ObjectiveUiStyle_DiscoverableExotic:
+		return true
+	}
+	return false
+}
+
 func (e PartyMemberStates) Enum() string {
 	switch e {
 	case PartyMemberStates_None:
@@ -50701,6 +54746,23 @@ func (e PartyMemberStates) Enum() string {
 	return fmt.Sprintf("PartyMemberStates_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e PartyMemberStates) Valid() bool {
+	switch e {
+	case PartyMemberStates_None:
+		return true
+	case PartyMemberStates_FireteamMember:
+		return true
+	case PartyMemberStates_PosseMember:
+		return true
+	case PartyMemberStates_GroupMember:
+		return true
+	case PartyMemberStates_PartyLeader:
+		return true
+	}
+	return false
+}
+
 func (e PresentationDisplayStyle) Enum() string {
 	switch e {
 	case PresentationDisplayStyle_Category:
@@ -50749,6 +54811,55 @@ func (e PresentationDisplayStyle) Enum() string {
 	return fmt.Sprintf("PresentationDisplayStyle_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e PresentationDisplayStyle) Valid() bool {
+	switch e {
+	case PresentationDisplayStyle_Category:
+		return true
+	case PresentationDisplayStyle_Badge:
+		return true
+	case PresentationDisplayStyle_Medals:
+		return true
+	case PresentationDisplayStyle_Collectible:
+		return true
+	case PresentationDisplayStyle_Record:
+		return true
+	case PresentationDisplayStyle_SeasonalTriumph:
+		return true
+	case PresentationDisplayStyle_GuardianRank:
+		return true
+	case PresentationDisplayStyle_CategoryCollectibles:
+		return true
+	case PresentationDisplayStyle_CategoryCurrencies:
+		return true
+	case PresentationDisplayStyle_CategoryEmblems:
+		return true
+	case PresentationDisplayStyle_CategoryEmotes:
+		return true
+	case PresentationDisplayStyle_CategoryEngrams:
+		return true
+	case PresentationDisplayStyle_CategoryFinishers:
+		return true
+	case PresentationDisplayStyle_CategoryGhosts:
+		return true
+	case PresentationDisplayStyle_CategoryMisc:
+		return true
+	case PresentationDisplayStyle_CategoryMods:
+		return true
+	case PresentationDisplayStyle_CategoryOrnaments:
+		return true
+	case PresentationDisplayStyle_CategoryShaders:
+		return true
+	case PresentationDisplayStyle_CategoryShips:
+		return true
+	case PresentationDisplayStyle_CategorySpawnfx:
+		return true
+	case PresentationDisplayStyle_CategoryUpgradeMaterials:
+		return true
+	}
+	return false
+}
+
 func (e PresentationNodeState) Enum() string {
 	switch e {
 	case PresentationNodeState_None:
@@ -50761,6 +54872,19 @@ func (e PresentationNodeState) Enum() string {
 	return fmt.Sprintf("PresentationNodeState_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e PresentationNodeState) Valid() bool {
+	switch e {
+	case PresentationNodeState_None:
+		return true
+	case PresentationNodeState_Invisible:
+		return true
+	case PresentationNodeState_Obscured:
+		return true
+	}
+	return false
+}
+
 func (e PresentationNodeType) Enum() string {
 	switch e {
 	case PresentationNodeType_Default:
@@ -50779,6 +54903,25 @@ func (e PresentationNodeType) Enum() string {
 	return fmt.Sprintf("PresentationNodeType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e PresentationNodeType) Valid() bool {
+	switch e {
+	case PresentationNodeType_Default:
+		return true
+	case PresentationNodeType_Category:
+		return true
+	case PresentationNodeType_Collectibles:
+		return true
+	case PresentationNodeType_Records:
+		return true
+	case PresentationNodeType_Metric:
+		return true
+	case PresentationNodeType_Craftable:
+		return true
+	}
+	return false
+}
+
 func (e PresentationScreenStyle) Enum() string {
 	switch e {
 	case PresentationScreenStyle_Default:
@@ -50791,6 +54934,19 @@ func (e PresentationScreenStyle) Enum() string {
 	return fmt.Sprintf("PresentationScreenStyle_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e PresentationScreenStyle) Valid() bool {
+	switch e {
+	case PresentationScreenStyle_Default:
+		return true
+	case PresentationScreenStyle_CategorySets:
+		return true
+	case PresentationScreenStyle_Badge:
+		return true
+	}
+	return false
+}
+
 func (e ProgressionRewardItemAcquisitionBehavior) Enum() string {
 	switch e {
 	case ProgressionRewardItemAcquisitionBehavior_Instant:
@@ -50801,6 +54957,17 @@ func (e ProgressionRewardItemAcquisitionBehavior) Enum() string {
 	return fmt.Sprintf("ProgressionRewardItemAcquisitionBehavior_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ProgressionRewardItemAcquisitionBehavior) Valid() bool {
+	switch e {
+	case ProgressionRewardItemAcquisitionBehavior_Instant:
+		return true
+	case ProgressionRewardItemAcquisitionBehavior_PlayerClaimRequired:
+		return true
+	}
+	return false
+}
+
 func (e ProgressionRewardItemState) Enum() string {
 	switch e {
 	case ProgressionRewardItemState_None:
@@ -50817,6 +54984,23 @@ func (e ProgressionRewardItemState) Enum() string {
 	return fmt.Sprintf("ProgressionRewardItemState_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ProgressionRewardItemState) Valid() bool {
+	switch e {
+	case ProgressionRewardItemState_None:
+		return true
+	case ProgressionRewardItemState_Invisible:
+		return true
+	case ProgressionRewardItemState_Earned:
+		return true
+	case ProgressionRewardItemState_Claimed:
+		return true
+	case ProgressionRewardItemState_ClaimAllowed:
+		return true
+	}
+	return false
+}
+
 func (e ProgressionScope) Enum() string {
 	switch e {
 	case ProgressionScope_Account:
@@ -50841,6 +55025,31 @@ func (e ProgressionScope) Enum() string {
 	return fmt.Sprintf("ProgressionScope_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ProgressionScope) Valid() bool {
+	switch e {
+	case ProgressionScope_Account:
+		return true
+	case ProgressionScope_Character:
+		return true
+	case ProgressionScope_Clan:
+		return true
+	case ProgressionScope_Item:
+		return true
+	case ProgressionScope_ImplicitFromEquipment:
+		return true
+	case ProgressionScope_Mapped:
+		return true
+	case ProgressionScope_MappedAggregate:
+		return true
+	case ProgressionScope_MappedStat:
+		return true
+	case ProgressionScope_MappedUnlockValue:
+		return true
+	}
+	return false
+}
+
 func (e ProgressionStepDisplayEffect) Enum() string {
 	switch e {
 	case ProgressionStepDisplayEffect_None:
@@ -50853,6 +55062,19 @@ func (e ProgressionStepDisplayEffect) Enum() string {
 	return fmt.Sprintf("ProgressionStepDisplayEffect_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ProgressionStepDisplayEffect) Valid() bool {
+	switch e {
+	case ProgressionStepDisplayEffect_None:
+		return true
+	case ProgressionStepDisplayEffect_Character:
+		return true
+	case ProgressionStepDisplayEffect_Item:
+		return true
+	}
+	return false
+}
+
 func (e Race) Enum() string {
 	switch e {
 	case Race_Human:
@@ -50867,6 +55089,21 @@ func (e Race) Enum() string {
 	return fmt.Sprintf("Race_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e Race) Valid() bool {
+	switch e {
+	case Race_Human:
+		return true
+	case Race_Awoken:
+		return true
+	case Race_Exo:
+		return true
+	case Race_Unknown:
+		return true
+	}
+	return false
+}
+
 func (e RecordState) Enum() string {
 	switch e {
 	case RecordState_None:
@@ -50889,6 +55126,29 @@ func (e RecordState) Enum() string {
 	return fmt.Sprintf("RecordState_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e RecordState) Valid() bool {
+	switch e {
+	case RecordState_None:
+		return true
+	case RecordState_RecordRedeemed:
+		return true
+	case RecordState_RewardUnavailable:
+		return true
+	case RecordState_ObjectiveNotCompleted:
+		return true
+	case RecordState_Obscured:
+		return true
+	case RecordState_Invisible:
+		return true
+	case RecordState_EntitlementUnowned:
+		return true
+	case RecordState_CanEquipTitle:
+		return true
+	}
+	return false
+}
+
 func (e RecordToastStyle) Enum() string {
 	switch e {
 	case RecordToastStyle_None:
@@ -50929,6 +55189,47 @@ func (e RecordToastStyle) Enum() string {
 	return fmt.Sprintf("RecordToastStyle_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e RecordToastStyle) Valid() bool {
+	switch e {
+	case RecordToastStyle_None:
+		return true
+	case RecordToastStyle_Record:
+		return true
+	case RecordToastStyle_Lore:
+		return true
+	case RecordToastStyle_Badge:
+		return true
+	case RecordToastStyle_MetaRecord:
+		return true
+	case RecordToastStyle_MedalComplete:
+		return true
+	case RecordToastStyle_SeasonChallengeComplete:
+		return true
+	case RecordToastStyle_GildedTitleComplete:
+		return true
+	case RecordToastStyle_CraftingRecipeUnlocked:
+		return true
+	case RecordToastStyle_ToastGuardianRankDetails:
+		return true
+	case RecordToastStyle_PathfinderObjectiveCompleteRituals:
+		return true
+	case RecordToastStyle_PathfinderObjectiveCompleteSchism:
+		return true
+	case RecordToastStyle_PathfinderObjectiveCompletePvp:
+		return true
+	case RecordToastStyle_PathfinderObjectiveCompleteStrikes:
+		return true
+	case RecordToastStyle_PathfinderObjectiveCompleteGambit:
+		return true
+	case RecordToastStyle_SeasonWeeklyComplete:
+		return true
+	case RecordToastStyle_SeasonDailyComplete:
+		return true
+	}
+	return false
+}
+
 func (e RecordValueStyle) Enum() string {
 	switch e {
 	case RecordValueStyle_Integer:
@@ -50945,6 +55246,23 @@ func (e RecordValueStyle) Enum() string {
 	return fmt.Sprintf("RecordValueStyle_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e RecordValueStyle) Valid() bool {
+	switch e {
+	case RecordValueStyle_Integer:
+		return true
+	case RecordValueStyle_Percentage:
+		return true
+	case RecordValueStyle_Milliseconds:
+		return true
+	case RecordValueStyle_Boolean:
+		return true
+	case RecordValueStyle_Decimal:
+		return true
+	}
+	return false
+}
+
 func (e Scope) Enum() string {
 	switch e {
 	case Scope_Profile:
@@ -50955,6 +55273,17 @@ func (e Scope) Enum() string {
 	return fmt.Sprintf("Scope_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e Scope) Valid() bool {
+	switch e {
+	case Scope_Profile:
+		return true
+	case Scope_Character:
+		return true
+	}
+	return false
+}
+
 func (e SocketCategoryStyle) Enum() string {
 	switch e {
 	case SocketCategoryStyle_Unknown:
@@ -50979,6 +55308,31 @@ func (e SocketCategoryStyle) Enum() string {
 	return fmt.Sprintf("SocketCategoryStyle_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e SocketCategoryStyle) Valid() bool {
+	switch e {
+	case SocketCategoryStyle_Unknown:
+		return true
+	case SocketCategoryStyle_Reusable:
+		return true
+	case SocketCategoryStyle_Consumable:
+		return true
+	case SocketCategoryStyle_Unlockable:
+		return true
+	case SocketCategoryStyle_Intrinsic:
+		return true
+	case SocketCategoryStyle_EnergyMeter:
+		return true
+	case SocketCategoryStyle_LargePerk:
+		return true
+	case SocketCategoryStyle_Abilities:
+		return true
+	case SocketCategoryStyle_Supers:
+		return true
+	}
+	return false
+}
+
 func (e SocketVisibility) Enum() string {
 	switch e {
 	case SocketVisibility_Visible:
@@ -50993,6 +55347,21 @@ func (e SocketVisibility) Enum() string {
 	return fmt.Sprintf("SocketVisibility_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e SocketVisibility) Valid() bool {
+	switch e {
+	case SocketVisibility_Visible:
+		return true
+	case SocketVisibility_Hidden:
+		return true
+	case SocketVisibility_HiddenWhenEmpty:
+		return true
+	case SocketVisibility_HiddenIfNoPlugsAvailable:
+		return true
+	}
+	return false
+}
+
 func (e StatAggregationType) Enum() string {
 	switch e {
 	case StatAggregationType_CharacterAverage:
@@ -51005,6 +55374,19 @@ func (e StatAggregationType) Enum() string {
 	return fmt.Sprintf("StatAggregationType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e StatAggregationType) Valid() bool {
+	switch e {
+	case StatAggregationType_CharacterAverage:
+		return true
+	case StatAggregationType_Character:
+		return true
+	case StatAggregationType_Item:
+		return true
+	}
+	return false
+}
+
 func (e StatCategory) Enum() string {
 	switch e {
 	case StatCategory_Gameplay:
@@ -51019,6 +55401,21 @@ func (e StatCategory) Enum() string {
 	return fmt.Sprintf("StatCategory_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e StatCategory) Valid() bool {
+	switch e {
+	case StatCategory_Gameplay:
+		return true
+	case StatCategory_Weapon:
+		return true
+	case StatCategory_Defense:
+		return true
+	case StatCategory_Primary:
+		return true
+	}
+	return false
+}
+
 func (e TalentNodeState) Enum() string {
 	switch e {
 	case TalentNodeState_Invalid:
@@ -51053,6 +55450,41 @@ func (e TalentNodeState) Enum() string {
 	return fmt.Sprintf("TalentNodeState_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e TalentNodeState) Valid() bool {
+	switch e {
+	case TalentNodeState_Invalid:
+		return true
+	case TalentNodeState_CanUpgrade:
+		return true
+	case TalentNodeState_NoPoints:
+		return true
+	case TalentNodeState_NoPrerequisites:
+		return true
+	case TalentNodeState_NoSteps:
+		return true
+	case TalentNodeState_NoUnlock:
+		return true
+	case TalentNodeState_NoMaterial:
+		return true
+	case TalentNodeState_NoGridLevel:
+		return true
+	case TalentNodeState_SwappingLocked:
+		return true
+	case TalentNodeState_MustSwap:
+		return true
+	case TalentNodeState_Complete:
+		return true
+	case TalentNodeState_Unknown:
+		return true
+	case TalentNodeState_CreationOnly:
+		return true
+	case TalentNodeState_Hidden:
+		return true
+	}
+	return false
+}
+
 func (e UnlockValueUIStyle) Enum() string {
 	switch e {
 	case UnlockValueUIStyle_Automatic:
@@ -51089,6 +55521,43 @@ func (e UnlockValueUIStyle) Enum() string {
 	return fmt.Sprintf("UnlockValueUIStyle_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e UnlockValueUIStyle) Valid() bool {
+	switch e {
+	case UnlockValueUIStyle_Automatic:
+		return true
+	case UnlockValueUIStyle_Fraction:
+		return true
+	case UnlockValueUIStyle_Checkbox:
+		return true
+	case UnlockValueUIStyle_Percentage:
+		return true
+	case UnlockValueUIStyle_DateTime:
+		return true
+	case UnlockValueUIStyle_FractionFloat:
+		return true
+	case UnlockValueUIStyle_Integer:
+		return true
+	case UnlockValueUIStyle_TimeDuration:
+		return true
+	case UnlockValueUIStyle_Hidden:
+		return true
+	case UnlockValueUIStyle_Multiplier:
+		return true
+	case UnlockValueUIStyle_GreenPips:
+		return true
+	case UnlockValueUIStyle_RedPips:
+		return true
+	case UnlockValueUIStyle_ExplicitPercentage:
+		return true
+	case UnlockValueUIStyle_RawFloat:
+		return true
+	case UnlockValueUIStyle_LevelAndReward:
+		return true
+	}
+	return false
+}
+
 func (e VendorFilter) Enum() string {
 	switch e {
 	case VendorFilter_None:
@@ -51099,6 +55568,17 @@ func (e VendorFilter) Enum() string {
 	return fmt.Sprintf("VendorFilter_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e VendorFilter) Valid() bool {
+	switch e {
+	case VendorFilter_None:
+		return true
+	case VendorFilter_ApiPurchasable:
+		return true
+	}
+	return false
+}
+
 func (e VendorInteractionRewardSelection) Enum() string {
 	switch e {
 	case VendorInteractionRewardSelection_None:
@@ -51111,6 +55591,19 @@ func (e VendorInteractionRewardSelection) Enum() string {
 	return fmt.Sprintf("VendorInteractionRewardSelection_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e VendorInteractionRewardSelection) Valid() bool {
+	switch e {
+	case VendorInteractionRewardSelection_None:
+		return true
+	case VendorInteractionRewardSelection_One:
+		return true
+	case VendorInteractionRewardSelection_All:
+		return true
+	}
+	return false
+}
+
 func (e VendorItemRefundPolicy) Enum() string {
 	switch e {
 	case VendorItemRefundPolicy_NotRefundable:
@@ -51123,6 +55616,19 @@ func (e VendorItemRefundPolicy) Enum() string {
 	return fmt.Sprintf("VendorItemRefundPolicy_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e VendorItemRefundPolicy) Valid() bool {
+	switch e {
+	case VendorItemRefundPolicy_NotRefundable:
+		return true
+	case VendorItemRefundPolicy_DeletesItem:
+		return true
+	case VendorItemRefundPolicy_RevokesLicense:
+		return true
+	}
+	return false
+}
+
 func (e VendorItemState) Enum() string {
 	switch e {
 	case VendorItemState_None:
@@ -51183,6 +55689,67 @@ func (e VendorItemState) Enum() string {
 	return fmt.Sprintf("VendorItemState_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e VendorItemState) Valid() bool {
+	switch e {
+	case VendorItemState_None:
+		return true
+	case VendorItemState_Incomplete:
+		return true
+	case VendorItemState_RewardAvailable:
+		return true
+	case VendorItemState_Complete:
+		return true
+	case VendorItemState_New:
+		return true
+	case VendorItemState_Featured:
+		return true
+	case VendorItemState_Ending:
+		return true
+	case VendorItemState_OnSale:
+		return true
+	case VendorItemState_Owned:
+		return true
+	case VendorItemState_WideView:
+		return true
+	case VendorItemState_NexusAttention:
+		return true
+	case VendorItemState_SetDiscount:
+		return true
+	case VendorItemState_PriceDrop:
+		return true
+	case VendorItemState_DailyOffer:
+		return true
+	case VendorItemState_Charity:
+		return true
+	case VendorItemState_SeasonalRewardExpiration:
+		return true
+	case VendorItemState_BestDeal:
+		return true
+	case VendorItemState_Popular:
+		return true
+	case VendorItemState_Free:
+		return true
+	case VendorItemState_Locked:
+		return true
+	case VendorItemState_Paracausal:
+		return true
+	case VendorItemState_Cryptarch:
+		return true
+	case VendorItemState_ArtifactPerkOwned:
+		return true
+	case VendorItemState_Savings:
+		return true
+	case VendorItemState_Ineligible:
+		return true
+	case VendorItemState_ArtifactPerkBoosted:
+		return true
+	case VendorItemState_SeasonalArchiveFree:
+		return true
+	}
+	return false
+}
+
 func (e VendorProgressionType) Enum() string {
 	switch e {
 	case VendorProgressionType_Default:
@@ -51195,6 +55762,19 @@ func (e VendorProgressionType) Enum() string {
 	return fmt.Sprintf("VendorProgressionType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e VendorProgressionType) Valid() bool {
+	switch e {
+	case VendorProgressionType_Default:
+		return true
+	case VendorProgressionType_Ritual:
+		return true
+	case VendorProgressionType_NoSeasonalRefresh:
+		return true
+	}
+	return false
+}
+
 func (e VendorReplyType) Enum() string {
 	switch e {
 	case VendorReplyType_Accept:
@@ -51207,6 +55787,19 @@ func (e VendorReplyType) Enum() string {
 	return fmt.Sprintf("VendorReplyType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e VendorReplyType) Valid() bool {
+	switch e {
+	case VendorReplyType_Accept:
+		return true
+	case VendorReplyType_Decline:
+		return true
+	case VendorReplyType_Complete:
+		return true
+	}
+	return false
+}
+
 func (e EquipFailureReason) Enum() string {
 	switch e {
 	case EquipFailureReason_None:
@@ -51231,6 +55824,31 @@ func (e EquipFailureReason) Enum() string {
 	return fmt.Sprintf("EquipFailureReason_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e EquipFailureReason) Valid() bool {
+	switch e {
+	case EquipFailureReason_None:
+		return true
+	case EquipFailureReason_ItemUnequippable:
+		return true
+	case EquipFailureReason_ItemUniqueEquipRestricted:
+		return true
+	case EquipFailureReason_ItemFailedUnlockCheck:
+		return true
+	case EquipFailureReason_ItemFailedLevelCheck:
+		return true
+	case EquipFailureReason_ItemWrapped:
+		return true
+	case EquipFailureReason_ItemNotLoaded:
+		return true
+	case EquipFailureReason_ItemEquipBlocklisted:
+		return true
+	case EquipFailureReason_ItemLoadoutRequirementNotMet:
+		return true
+	}
+	return false
+}
+
 func (e EquippingItemBlockAttributes) Enum() string {
 	switch e {
 	case EquippingItemBlockAttributes_None:
@@ -51241,6 +55859,17 @@ func (e EquippingItemBlockAttributes) Enum() string {
 	return fmt.Sprintf("EquippingItemBlockAttributes_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e EquippingItemBlockAttributes) Valid() bool {
+	switch e {
+	case EquippingItemBlockAttributes_None:
+		return true
+	case EquippingItemBlockAttributes_EquipOnAcquire:
+		return true
+	}
+	return false
+}
+
 func (e FireteamFinderCodeOptionType) Enum() string {
 	switch e {
 	case FireteamFinderCodeOptionType_None:
@@ -51263,6 +55892,29 @@ func (e FireteamFinderCodeOptionType) Enum() string {
 	return fmt.Sprintf("FireteamFinderCodeOptionType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e FireteamFinderCodeOptionType) Valid() bool {
+	switch e {
+	case FireteamFinderCodeOptionType_None:
+		return true
+	case FireteamFinderCodeOptionType_ApplicationOnly:
+		return true
+	case FireteamFinderCodeOptionType_OnlineOnly:
+		return true
+	case FireteamFinderCodeOptionType_PlayerCount:
+		return true
+	case FireteamFinderCodeOptionType_Title:
+		return true
+	case FireteamFinderCodeOptionType_Tags:
+		return true
+	case FireteamFinderCodeOptionType_FinderActivityGraph:
+		return true
+	case FireteamFinderCodeOptionType_MicrophoneRequired:
+		return true
+	}
+	return false
+}
+
 func (e FireteamFinderLabelFieldType) Enum() string {
 	switch e {
 	case FireteamFinderLabelFieldType_Title:
@@ -51273,6 +55925,17 @@ func (e FireteamFinderLabelFieldType) Enum() string {
 	return fmt.Sprintf("FireteamFinderLabelFieldType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e FireteamFinderLabelFieldType) Valid() bool {
+	switch e {
+	case FireteamFinderLabelFieldType_Title:
+		return true
+	case FireteamFinderLabelFieldType_Label:
+		return true
+	}
+	return false
+}
+
 func (e FireteamFinderOptionAvailability) Enum() string {
 	switch e {
 	case FireteamFinderOptionAvailability_None:
@@ -51289,6 +55952,23 @@ func (e FireteamFinderOptionAvailability) Enum() string {
 	return fmt.Sprintf("FireteamFinderOptionAvailability_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e FireteamFinderOptionAvailability) Valid() bool {
+	switch e {
+	case FireteamFinderOptionAvailability_None:
+		return true
+	case FireteamFinderOptionAvailability_CreateListingBuilder:
+		return true
+	case FireteamFinderOptionAvailability_SearchListingBuilder:
+		return true
+	case FireteamFinderOptionAvailability_ListingViewer:
+		return true
+	case FireteamFinderOptionAvailability_LobbyViewer:
+		return true
+	}
+	return false
+}
+
 func (e FireteamFinderOptionControlType) Enum() string {
 	switch e {
 	case FireteamFinderOptionControlType_None:
@@ -51301,6 +55981,19 @@ func (e FireteamFinderOptionControlType) Enum() string {
 	return fmt.Sprintf("FireteamFinderOptionControlType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e FireteamFinderOptionControlType) Valid() bool {
+	switch e {
+	case FireteamFinderOptionControlType_None:
+		return true
+	case FireteamFinderOptionControlType_ValueCollection:
+		return true
+	case FireteamFinderOptionControlType_RadioButton:
+		return true
+	}
+	return false
+}
+
 func (e FireteamFinderOptionDisplayFormat) Enum() string {
 	switch e {
 	case FireteamFinderOptionDisplayFormat_Text:
@@ -51315,6 +56008,21 @@ func (e FireteamFinderOptionDisplayFormat) Enum() string {
 	return fmt.Sprintf("FireteamFinderOptionDisplayFormat_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e FireteamFinderOptionDisplayFormat) Valid() bool {
+	switch e {
+	case FireteamFinderOptionDisplayFormat_Text:
+		return true
+	case FireteamFinderOptionDisplayFormat_Integer:
+		return true
+	case FireteamFinderOptionDisplayFormat_Bool:
+		return true
+	case FireteamFinderOptionDisplayFormat_FormatString:
+		return true
+	}
+	return false
+}
+
 func (e FireteamFinderOptionSearchFilterType) Enum() string {
 	switch e {
 	case FireteamFinderOptionSearchFilterType_None:
@@ -51339,6 +56047,31 @@ func (e FireteamFinderOptionSearchFilterType) Enum() string {
 	return fmt.Sprintf("FireteamFinderOptionSearchFilterType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e FireteamFinderOptionSearchFilterType) Valid() bool {
+	switch e {
+	case FireteamFinderOptionSearchFilterType_None:
+		return true
+	case FireteamFinderOptionSearchFilterType_All:
+		return true
+	case FireteamFinderOptionSearchFilterType_Any:
+		return true
+	case FireteamFinderOptionSearchFilterType_InRangeInclusive:
+		return true
+	case FireteamFinderOptionSearchFilterType_InRangeExclusive:
+		return true
+	case FireteamFinderOptionSearchFilterType_GreaterThan:
+		return true
+	case FireteamFinderOptionSearchFilterType_GreaterThanOrEqualTo:
+		return true
+	case FireteamFinderOptionSearchFilterType_LessThan:
+		return true
+	case FireteamFinderOptionSearchFilterType_LessThanOrEqualTo:
+		return true
+	}
+	return false
+}
+
 func (e FireteamFinderOptionValueFlags) Enum() string {
 	switch e {
 	case FireteamFinderOptionValueFlags_None:
@@ -51351,6 +56084,19 @@ func (e FireteamFinderOptionValueFlags) Enum() string {
 	return fmt.Sprintf("FireteamFinderOptionValueFlags_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e FireteamFinderOptionValueFlags) Valid() bool {
+	switch e {
+	case FireteamFinderOptionValueFlags_None:
+		return true
+	case FireteamFinderOptionValueFlags_CreateListingDefaultValue:
+		return true
+	case FireteamFinderOptionValueFlags_SearchFilterDefaultValue:
+		return true
+	}
+	return false
+}
+
 func (e FireteamFinderOptionValueProviderType) Enum() string {
 	switch e {
 	case FireteamFinderOptionValueProviderType_None:
@@ -51369,6 +56115,25 @@ func (e FireteamFinderOptionValueProviderType) Enum() string {
 	return fmt.Sprintf("FireteamFinderOptionValueProviderType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e FireteamFinderOptionValueProviderType) Valid() bool {
+	switch e {
+	case FireteamFinderOptionValueProviderType_None:
+		return true
+	case FireteamFinderOptionValueProviderType_Values:
+		return true
+	case FireteamFinderOptionValueProviderType_PlayerCount:
+		return true
+	case FireteamFinderOptionValueProviderType_FireteamFinderLabels:
+		return true
+	case FireteamFinderOptionValueProviderType_FireteamFinderActivityGraph:
+		return true
+	case FireteamFinderOptionValueProviderType_FireteamFinderUIActivityTree:
+		return true
+	}
+	return false
+}
+
 func (e FireteamFinderOptionVisibility) Enum() string {
 	switch e {
 	case FireteamFinderOptionVisibility_Always:
@@ -51379,6 +56144,17 @@ func (e FireteamFinderOptionVisibility) Enum() string {
 	return fmt.Sprintf("FireteamFinderOptionVisibility_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e FireteamFinderOptionVisibility) Valid() bool {
+	switch e {
+	case FireteamFinderOptionVisibility_Always:
+		return true
+	case FireteamFinderOptionVisibility_ShowWhenChangedFromDefault:
+		return true
+	}
+	return false
+}
+
 func (e ActivityModeType) Enum() string {
 	switch e {
 	case ActivityModeType_None:
@@ -51555,6 +56331,183 @@ func (e ActivityModeType) Enum() string {
 	return fmt.Sprintf("ActivityModeType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ActivityModeType) Valid() bool {
+	switch e {
+	case ActivityModeType_None:
+		return true
+	case ActivityModeType_Story:
+		return true
+	case ActivityModeType_Strike:
+		return true
+	case ActivityModeType_Raid:
+		return true
+	case ActivityModeType_AllPvP:
+		return true
+	case ActivityModeType_Patrol:
+		return true
+	case ActivityModeType_AllPvE:
+		return true
+	case ActivityModeType_Reserved9:
+		return true
+	case ActivityModeType_Control:
+		return true
+	case ActivityModeType_Reserved11:
+		return true
+	case ActivityModeType_Clash:
+		return true
+	case ActivityModeType_Reserved13:
+		return true
+	case ActivityModeType_CrimsonDoubles:
+		return true
+	case ActivityModeType_Nightfall:
+		return true
+	case ActivityModeType_HeroicNightfall:
+		return true
+	case ActivityModeType_AllStrikes:
+		return true
+	case ActivityModeType_IronBanner:
+		return true
+	case ActivityModeType_Reserved20:
+		return true
+	case ActivityModeType_Reserved21:
+		return true
+	case ActivityModeType_Reserved22:
+		return true
+	case ActivityModeType_Reserved24:
+		return true
+	case ActivityModeType_AllMayhem:
+		return true
+	case ActivityModeType_Reserved26:
+		return true
+	case ActivityModeType_Reserved27:
+		return true
+	case ActivityModeType_Reserved28:
+		return true
+	case ActivityModeType_Reserved29:
+		return true
+	case ActivityModeType_Reserved30:
+		return true
+	case ActivityModeType_Supremacy:
+		return true
+	case ActivityModeType_PrivateMatchesAll:
+		return true
+	case ActivityModeType_Survival:
+		return true
+	case ActivityModeType_Countdown:
+		return true
+	case ActivityModeType_TrialsOfTheNine:
+		return true
+	case ActivityModeType_Social:
+		return true
+	case ActivityModeType_TrialsCountdown:
+		return true
+	case ActivityModeType_TrialsSurvival:
+		return true
+	case ActivityModeType_IronBannerControl:
+		return true
+	case ActivityModeType_IronBannerClash:
+		return true
+	case ActivityModeType_IronBannerSupremacy:
+		return true
+	case ActivityModeType_ScoredNightfall:
+		return true
+	case ActivityModeType_ScoredHeroicNightfall:
+		return true
+	case ActivityModeType_Rumble:
+		return true
+	case ActivityModeType_AllDoubles:
+		return true
+	case ActivityModeType_Doubles:
+		return true
+	case ActivityModeType_PrivateMatchesClash:
+		return true
+	case ActivityModeType_PrivateMatchesControl:
+		return true
+	case ActivityModeType_PrivateMatchesSupremacy:
+		return true
+	case ActivityModeType_PrivateMatchesCountdown:
+		return true
+	case ActivityModeType_PrivateMatchesSurvival:
+		return true
+	case ActivityModeType_PrivateMatchesMayhem:
+		return true
+	case ActivityModeType_PrivateMatchesRumble:
+		return true
+	case ActivityModeType_HeroicAdventure:
+		return true
+	case ActivityModeType_Showdown:
+		return true
+	case ActivityModeType_Lockdown:
+		return true
+	case ActivityModeType_Scorched:
+		return true
+	case ActivityModeType_ScorchedTeam:
+		return true
+	case ActivityModeType_Gambit:
+		return true
+	case ActivityModeType_AllPvECompetitive:
+		return true
+	case ActivityModeType_Breakthrough:
+		return true
+	case ActivityModeType_BlackArmoryRun:
+		return true
+	case ActivityModeType_Salvage:
+		return true
+	case ActivityModeType_IronBannerSalvage:
+		return true
+	case ActivityModeType_PvPCompetitive:
+		return true
+	case ActivityModeType_PvPQuickplay:
+		return true
+	case ActivityModeType_ClashQuickplay:
+		return true
+	case ActivityModeType_ClashCompetitive:
+		return true
+	case ActivityModeType_ControlQuickplay:
+		return true
+	case ActivityModeType_ControlCompetitive:
+		return true
+	case ActivityModeType_GambitPrime:
+		return true
+	case ActivityModeType_Reckoning:
+		return true
+	case ActivityModeType_Menagerie:
+		return true
+	case ActivityModeType_VexOffensive:
+		return true
+	case ActivityModeType_NightmareHunt:
+		return true
+	case ActivityModeType_Elimination:
+		return true
+	case ActivityModeType_Momentum:
+		return true
+	case ActivityModeType_Dungeon:
+		return true
+	case ActivityModeType_Sundial:
+		return true
+	case ActivityModeType_TrialsOfOsiris:
+		return true
+	case ActivityModeType_Dares:
+		return true
+	case ActivityModeType_Offensive:
+		return true
+	case ActivityModeType_LostSector:
+		return true
+	case ActivityModeType_Rift:
+		return true
+	case ActivityModeType_ZoneControl:
+		return true
+	case ActivityModeType_IronBannerRift:
+		return true
+	case ActivityModeType_IronBannerZoneControl:
+		return true
+	case ActivityModeType_Relic:
+		return true
+	}
+	return false
+}
+
 func (e StatsCategoryType) Enum() string {
 	switch e {
 	case StatsCategoryType_None:
@@ -51593,6 +56546,45 @@ func (e StatsCategoryType) Enum() string {
 	return fmt.Sprintf("StatsCategoryType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e StatsCategoryType) Valid() bool {
+	switch e {
+	case StatsCategoryType_None:
+		return true
+	case StatsCategoryType_Kills:
+		return true
+	case StatsCategoryType_Assists:
+		return true
+	case StatsCategoryType_Deaths:
+		return true
+	case StatsCategoryType_Criticals:
+		return true
+	case StatsCategoryType_KDa:
+		return true
+	case StatsCategoryType_KD:
+		return true
+	case StatsCategoryType_Score:
+		return true
+	case StatsCategoryType_Entered:
+		return true
+	case StatsCategoryType_TimePlayed:
+		return true
+	case StatsCategoryType_MedalWins:
+		return true
+	case StatsCategoryType_MedalGame:
+		return true
+	case StatsCategoryType_MedalSpecialKills:
+		return true
+	case StatsCategoryType_MedalSprees:
+		return true
+	case StatsCategoryType_MedalMultiKills:
+		return true
+	case StatsCategoryType_MedalAbilities:
+		return true
+	}
+	return false
+}
+
 func (e StatsGroupType) Enum() string {
 	switch e {
 	case StatsGroupType_None:
@@ -51617,6 +56609,31 @@ func (e StatsGroupType) Enum() string {
 	return fmt.Sprintf("StatsGroupType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e StatsGroupType) Valid() bool {
+	switch e {
+	case StatsGroupType_None:
+		return true
+	case StatsGroupType_General:
+		return true
+	case StatsGroupType_Weapons:
+		return true
+	case StatsGroupType_Medals:
+		return true
+	case StatsGroupType_ReservedGroups:
+		return true
+	case StatsGroupType_Leaderboard:
+		return true
+	case StatsGroupType_Activity:
+		return true
+	case StatsGroupType_UniqueWeapon:
+		return true
+	case StatsGroupType_Internal:
+		return true
+	}
+	return false
+}
+
 func (e StatsMergeMethod) Enum() string {
 	switch e {
 	case StatsMergeMethod_Add:
@@ -51629,6 +56646,19 @@ func (e StatsMergeMethod) Enum() string {
 	return fmt.Sprintf("StatsMergeMethod_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e StatsMergeMethod) Valid() bool {
+	switch e {
+	case StatsMergeMethod_Add:
+		return true
+	case StatsMergeMethod_Min:
+		return true
+	case StatsMergeMethod_Max:
+		return true
+	}
+	return false
+}
+
 func (e PeriodType) Enum() string {
 	switch e {
 	case PeriodType_None:
@@ -51643,6 +56673,21 @@ func (e PeriodType) Enum() string {
 	return fmt.Sprintf("PeriodType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e PeriodType) Valid() bool {
+	switch e {
+	case PeriodType_None:
+		return true
+	case PeriodType_Daily:
+		return true
+	case PeriodType_AllTime:
+		return true
+	case PeriodType_Activity:
+		return true
+	}
+	return false
+}
+
 func (e UnitType) Enum() string {
 	switch e {
 	case UnitType_None:
@@ -51677,6 +56722,41 @@ func (e UnitType) Enum() string {
 	return fmt.Sprintf("UnitType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e UnitType) Valid() bool {
+	switch e {
+	case UnitType_None:
+		return true
+	case UnitType_Count:
+		return true
+	case UnitType_PerGame:
+		return true
+	case UnitType_Seconds:
+		return true
+	case UnitType_Points:
+		return true
+	case UnitType_Team:
+		return true
+	case UnitType_Distance:
+		return true
+	case UnitType_Percent:
+		return true
+	case UnitType_Ratio:
+		return true
+	case UnitType_Boolean:
+		return true
+	case UnitType_WeaponType:
+		return true
+	case UnitType_Standing:
+		return true
+	case UnitType_Milliseconds:
+		return true
+	case UnitType_CompletionReason:
+		return true
+	}
+	return false
+}
+
 func (e ItemBindStatus) Enum() string {
 	switch e {
 	case ItemBindStatus_NotBound:
@@ -51691,6 +56771,21 @@ func (e ItemBindStatus) Enum() string {
 	return fmt.Sprintf("ItemBindStatus_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ItemBindStatus) Valid() bool {
+	switch e {
+	case ItemBindStatus_NotBound:
+		return true
+	case ItemBindStatus_BoundToCharacter:
+		return true
+	case ItemBindStatus_BoundToAccount:
+		return true
+	case ItemBindStatus_BoundToGuild:
+		return true
+	}
+	return false
+}
+
 func (e ItemLocation) Enum() string {
 	switch e {
 	case ItemLocation_Unknown:
@@ -51707,6 +56802,23 @@ func (e ItemLocation) Enum() string {
 	return fmt.Sprintf("ItemLocation_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ItemLocation) Valid() bool {
+	switch e {
+	case ItemLocation_Unknown:
+		return true
+	case ItemLocation_Inventory:
+		return true
+	case ItemLocation_Vault:
+		return true
+	case ItemLocation_Vendor:
+		return true
+	case ItemLocation_Postmaster:
+		return true
+	}
+	return false
+}
+
 func (e ItemPerkVisibility) Enum() string {
 	switch e {
 	case ItemPerkVisibility_Visible:
@@ -51719,6 +56831,19 @@ func (e ItemPerkVisibility) Enum() string {
 	return fmt.Sprintf("ItemPerkVisibility_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ItemPerkVisibility) Valid() bool {
+	switch e {
+	case ItemPerkVisibility_Visible:
+		return true
+	case ItemPerkVisibility_Disabled:
+		return true
+	case ItemPerkVisibility_Hidden:
+		return true
+	}
+	return false
+}
+
 func (e ItemState) Enum() string {
 	switch e {
 	case ItemState_None:
@@ -51739,6 +56864,27 @@ func (e ItemState) Enum() string {
 	return fmt.Sprintf("ItemState_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ItemState) Valid() bool {
+	switch e {
+	case ItemState_None:
+		return true
+	case ItemState_Locked:
+		return true
+	case ItemState_Tracked:
+		return true
+	case ItemState_Masterwork:
+		return true
+	case ItemState_Crafted:
+		return true
+	case ItemState_HighlightedObjective:
+		return true
+	case ItemState_Enhanced:
+		return true
+	}
+	return false
+}
+
 func (e PlugAvailabilityMode) Enum() string {
 	switch e {
 	case PlugAvailabilityMode_Normal:
@@ -51751,6 +56897,19 @@ func (e PlugAvailabilityMode) Enum() string {
 	return fmt.Sprintf("PlugAvailabilityMode_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e PlugAvailabilityMode) Valid() bool {
+	switch e {
+	case PlugAvailabilityMode_Normal:
+		return true
+	case PlugAvailabilityMode_UnavailableIfSocketContainsMatchingPlugCategory:
+		return true
+	case PlugAvailabilityMode_AvailableIfSocketContainsMatchingPlugCategory:
+		return true
+	}
+	return false
+}
+
 func (e PlugUiStyles) Enum() string {
 	switch e {
 	case PlugUiStyles_None:
@@ -51761,6 +56920,17 @@ func (e PlugUiStyles) Enum() string {
 	return fmt.Sprintf("PlugUiStyles_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e PlugUiStyles) Valid() bool {
+	switch e {
+	case PlugUiStyles_None:
+		return true
+	case PlugUiStyles_Masterwork:
+		return true
+	}
+	return false
+}
+
 func (e SocketArrayType) Enum() string {
 	switch e {
 	case SocketArrayType_Default:
@@ -51771,6 +56941,178 @@ func (e SocketArrayType) Enum() string {
 	return fmt.Sprintf("SocketArrayType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e SocketArrayType) Valid() bool {
+	switch e {
+	case SocketArrayType_Default:
+		return true
+	case SocketArrayType_Intrinsic:
+		return true
+	}
+	return false
+}
+
+// ComponentDependencies returns the ComponentType that must be requested to populate each field of
+// CharacterResponse.
+func (CharacterResponse) ComponentDependencies() map[string]ComponentType {
+	return map[string]ComponentType{
+		"Activities":        ComponentType_CharacterActivities,
+		"Character":         ComponentType_Characters,
+		"Collectibles":      ComponentType_Collectibles,
+		"CurrencyLookups":   ComponentType_CurrencyLookups,
+		"Equipment":         ComponentType_CharacterEquipment,
+		"Inventory":         ComponentType_CharacterInventories,
+		"Kiosks":            ComponentType_Kiosks,
+		"Loadouts":          ComponentType_CharacterLoadouts,
+		"PlugSets":          ComponentType_ItemSockets,
+		"PresentationNodes": ComponentType_PresentationNodes,
+		"Progressions":      ComponentType_CharacterProgressions,
+		"Records":           ComponentType_Records,
+		"RenderData":        ComponentType_CharacterRenderData,
+	}
+}
+
+// ComponentErrors returns ErrComponentPrivate or ErrComponentDisabled for each component of
+// CharacterResponse that was requested but not returned, keyed by field path.
+func (r CharacterResponse) ComponentErrors() map[string]error {
+	return componentErrors(r)
+}
+
+// ComponentDependencies returns the ComponentType that must be requested to populate each field of
+// CollectibleNodeDetailResponse.
+func (CollectibleNodeDetailResponse) ComponentDependencies() map[string]ComponentType {
+	return map[string]ComponentType{
+		"Collectibles": ComponentType_Collectibles,
+	}
+}
+
+// ComponentErrors returns ErrComponentPrivate or ErrComponentDisabled for each component of
+// CollectibleNodeDetailResponse that was requested but not returned, keyed by field path.
+func (r CollectibleNodeDetailResponse) ComponentErrors() map[string]error {
+	return componentErrors(r)
+}
+
+// ComponentDependencies returns the ComponentType that must be requested to populate each field of
+// ItemResponse.
+func (ItemResponse) ComponentDependencies() map[string]ComponentType {
+	return map[string]ComponentType{
+		"Instance":       ComponentType_ItemInstances,
+		"Item":           ComponentType_ItemCommonData,
+		"Objectives":     ComponentType_ItemObjectives,
+		"Perks":          ComponentType_ItemPerks,
+		"PlugObjectives": ComponentType_ItemPlugObjectives,
+		"RenderData":     ComponentType_ItemRenderData,
+		"ReusablePlugs":  ComponentType_ItemReusablePlugs,
+		"Sockets":        ComponentType_ItemSockets,
+		"Stats":          ComponentType_ItemStats,
+		"TalentGrid":     ComponentType_ItemTalentGrids,
+	}
+}
+
+// ComponentErrors returns ErrComponentPrivate or ErrComponentDisabled for each component of
+// ItemResponse that was requested but not returned, keyed by field path.
+func (r ItemResponse) ComponentErrors() map[string]error {
+	return componentErrors(r)
+}
+
+// ComponentDependencies returns the ComponentType that must be requested to populate each field of
+// ProfileResponse.
+func (ProfileResponse) ComponentDependencies() map[string]ComponentType {
+	return map[string]ComponentType{
+		"CharacterActivities":        ComponentType_CharacterActivities,
+		"CharacterCollectibles":      ComponentType_Collectibles,
+		"CharacterCraftables":        ComponentType_Craftables,
+		"CharacterCurrencyLookups":   ComponentType_CurrencyLookups,
+		"CharacterEquipment":         ComponentType_CharacterEquipment,
+		"CharacterInventories":       ComponentType_CharacterInventories,
+		"CharacterKiosks":            ComponentType_Kiosks,
+		"CharacterLoadouts":          ComponentType_CharacterLoadouts,
+		"CharacterPlugSets":          ComponentType_ItemSockets,
+		"CharacterPresentationNodes": ComponentType_PresentationNodes,
+		"CharacterProgressions":      ComponentType_CharacterProgressions,
+		"CharacterRecords":           ComponentType_Records,
+		"CharacterRenderData":        ComponentType_CharacterRenderData,
+		"CharacterStringVariables":   ComponentType_StringVariables,
+		"Characters":                 ComponentType_Characters,
+		"Metrics":                    ComponentType_Metrics,
+		"PlatformSilver":             ComponentType_PlatformSilver,
+		"Profile":                    ComponentType_Profiles,
+		"ProfileCollectibles":        ComponentType_Collectibles,
+		"ProfileCommendations":       ComponentType_SocialCommendations,
+		"ProfileCurrencies":          ComponentType_ProfileCurrencies,
+		"ProfileInventory":           ComponentType_ProfileInventories,
+		"ProfileKiosks":              ComponentType_Kiosks,
+		"ProfilePlugSets":            ComponentType_ItemSockets,
+		"ProfilePresentationNodes":   ComponentType_PresentationNodes,
+		"ProfileProgression":         ComponentType_ProfileProgression,
+		"ProfileRecords":             ComponentType_Records,
+		"ProfileStringVariables":     ComponentType_StringVariables,
+		"ProfileTransitoryData":      ComponentType_Transitory,
+		"VendorReceipts":             ComponentType_VendorReceipts,
+	}
+}
+
+// ComponentErrors returns ErrComponentPrivate or ErrComponentDisabled for each component of
+// ProfileResponse that was requested but not returned, keyed by field path.
+func (r ProfileResponse) ComponentErrors() map[string]error {
+	return componentErrors(r)
+}
+
+// ComponentDependencies returns the ComponentType that must be requested to populate each field of
+// PublicVendorsResponse.
+func (PublicVendorsResponse) ComponentDependencies() map[string]ComponentType {
+	return map[string]ComponentType{
+		"Categories":      ComponentType_VendorCategories,
+		"Sales":           ComponentType_VendorSales,
+		"StringVariables": ComponentType_StringVariables,
+		"VendorGroups":    ComponentType_Vendors,
+		"Vendors":         ComponentType_Vendors,
+	}
+}
+
+// ComponentErrors returns ErrComponentPrivate or ErrComponentDisabled for each component of
+// PublicVendorsResponse that was requested but not returned, keyed by field path.
+func (r PublicVendorsResponse) ComponentErrors() map[string]error {
+	return componentErrors(r)
+}
+
+// ComponentDependencies returns the ComponentType that must be requested to populate each field of
+// VendorResponse.
+func (VendorResponse) ComponentDependencies() map[string]ComponentType {
+	return map[string]ComponentType{
+		"Categories":      ComponentType_VendorCategories,
+		"CurrencyLookups": ComponentType_CurrencyLookups,
+		"Sales":           ComponentType_VendorSales,
+		"StringVariables": ComponentType_StringVariables,
+		"Vendor":          ComponentType_Vendors,
+	}
+}
+
+// ComponentErrors returns ErrComponentPrivate or ErrComponentDisabled for each component of
+// VendorResponse that was requested but not returned, keyed by field path.
+func (r VendorResponse) ComponentErrors() map[string]error {
+	return componentErrors(r)
+}
+
+// ComponentDependencies returns the ComponentType that must be requested to populate each field of
+// VendorsResponse.
+func (VendorsResponse) ComponentDependencies() map[string]ComponentType {
+	return map[string]ComponentType{
+		"Categories":      ComponentType_VendorCategories,
+		"CurrencyLookups": ComponentType_CurrencyLookups,
+		"Sales":           ComponentType_VendorSales,
+		"StringVariables": ComponentType_StringVariables,
+		"VendorGroups":    ComponentType_Vendors,
+		"Vendors":         ComponentType_Vendors,
+	}
+}
+
+// ComponentErrors returns ErrComponentPrivate or ErrComponentDisabled for each component of
+// VendorsResponse that was requested but not returned, keyed by field path.
+func (r VendorsResponse) ComponentErrors() map[string]error {
+	return componentErrors(r)
+}
+
 func (e SocketPlugSources) Enum() string {
 	switch e {
 	case SocketPlugSources_None:
@@ -51787,6 +57129,23 @@ func (e SocketPlugSources) Enum() string {
 	return fmt.Sprintf("SocketPlugSources_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e SocketPlugSources) Valid() bool {
+	switch e {
+	case SocketPlugSources_None:
+		return true
+	case SocketPlugSources_InventorySourced:
+		return true
+	case SocketPlugSources_ReusablePlugItems:
+		return true
+	case SocketPlugSources_ProfilePlugSet:
+		return true
+	case SocketPlugSources_CharacterPlugSet:
+		return true
+	}
+	return false
+}
+
 func (e SocketTypeActionType) Enum() string {
 	switch e {
 	case SocketTypeActionType_InsertPlug:
@@ -51799,6 +57158,19 @@ func (e SocketTypeActionType) Enum() string {
 	return fmt.Sprintf("SocketTypeActionType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e SocketTypeActionType) Valid() bool {
+	switch e {
+	case SocketTypeActionType_InsertPlug:
+		return true
+	case SocketTypeActionType_InfuseItem:
+		return true
+	case SocketTypeActionType_ReinitializeSocket:
+		return true
+	}
+	return false
+}
+
 func (e SpecialItemType) Enum() string {
 	switch e {
 	case SpecialItemType_None:
@@ -51823,6 +57195,31 @@ func (e SpecialItemType) Enum() string {
 	return fmt.Sprintf("SpecialItemType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e SpecialItemType) Valid() bool {
+	switch e {
+	case SpecialItemType_None:
+		return true
+	case SpecialItemType_SpecialCurrency:
+		return true
+	case SpecialItemType_Armor:
+		return true
+	case SpecialItemType_Weapon:
+		return true
+	case SpecialItemType_Engram:
+		return true
+	case SpecialItemType_Consumable:
+		return true
+	case SpecialItemType_ExchangeMaterial:
+		return true
+	case SpecialItemType_MissionReward:
+		return true
+	case SpecialItemType_Currency:
+		return true
+	}
+	return false
+}
+
 func (e TierType) Enum() string {
 	switch e {
 	case TierType_Unknown:
@@ -51843,6 +57240,27 @@ func (e TierType) Enum() string {
 	return fmt.Sprintf("TierType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e TierType) Valid() bool {
+	switch e {
+	case TierType_Unknown:
+		return true
+	case TierType_Currency:
+		return true
+	case TierType_Basic:
+		return true
+	case TierType_Common:
+		return true
+	case TierType_Rare:
+		return true
+	case TierType_Superior:
+		return true
+	case TierType_Exotic:
+		return true
+	}
+	return false
+}
+
 func (e TransferStatuses) Enum() string {
 	switch e {
 	case TransferStatuses_CanTransfer:
@@ -51857,6 +57275,21 @@ func (e TransferStatuses) Enum() string {
 	return fmt.Sprintf("TransferStatuses_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e TransferStatuses) Valid() bool {
+	switch e {
+	case TransferStatuses_CanTransfer:
+		return true
+	case TransferStatuses_ItemIsEquipped:
+		return true
+	case TransferStatuses_NotTransferrable:
+		return true
+	case TransferStatuses_NoRoomInDestination:
+		return true
+	}
+	return false
+}
+
 func (e VendorDisplayCategorySortOrder) Enum() string {
 	switch e {
 	case VendorDisplayCategorySortOrder_Default:
@@ -51867,6 +57300,17 @@ func (e VendorDisplayCategorySortOrder) Enum() string {
 	return fmt.Sprintf("VendorDisplayCategorySortOrder_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e VendorDisplayCategorySortOrder) Valid() bool {
+	switch e {
+	case VendorDisplayCategorySortOrder_Default:
+		return true
+	case VendorDisplayCategorySortOrder_SortByTier:
+		return true
+	}
+	return false
+}
+
 func (e VendorInteractionType) Enum() string {
 	switch e {
 	case VendorInteractionType_Unknown:
@@ -51895,20 +57339,49 @@ func (e VendorInteractionType) Enum() string {
 	return fmt.Sprintf("VendorInteractionType_%d", e)
 }
 
-func (e VendorItemStatus) Enum() string {
+// Valid reports whether e is one of the values defined by the API.
+func (e VendorInteractionType) Valid() bool {
 	switch e {
-	case VendorItemStatus_Success:
-		return "Success"
-	case VendorItemStatus_NoInventorySpace:
-		return "NoInventorySpace"
-	case VendorItemStatus_NoFunds:
-		return "NoFunds"
-	case VendorItemStatus_NoProgression:
-		return "NoProgression"
-	case VendorItemStatus_NoUnlock:
-		return "NoUnlock"
-	case VendorItemStatus_NoQuantity:
-		return "NoQuantity"
+	case VendorInteractionType_Unknown:
+		return true
+	case VendorInteractionType_Undefined:
+		return true
+	case VendorInteractionType_QuestComplete:
+		return true
+	case VendorInteractionType_QuestContinue:
+		return true
+	case VendorInteractionType_ReputationPreview:
+		return true
+	case VendorInteractionType_RankUpReward:
+		return true
+	case VendorInteractionType_TokenTurnIn:
+		return true
+	case VendorInteractionType_QuestAccept:
+		return true
+	case VendorInteractionType_ProgressTab:
+		return true
+	case VendorInteractionType_End:
+		return true
+	case VendorInteractionType_Start:
+		return true
+	}
+	return false
+}
+
+func (e VendorItemStatus) Enum() string {
+	switch e {
+	case VendorItemStatus_Success:
+		return "Success"
+	case VendorItemStatus_NoInventorySpace:
+		return "NoInventorySpace"
+	case VendorItemStatus_NoFunds:
+		return "NoFunds"
+	case VendorItemStatus_NoProgression:
+		return "NoProgression"
+	case VendorItemStatus_NoUnlock:
+		return "NoUnlock"
+	case VendorItemStatus_NoQuantity:
+		return "NoQuantity"
 	case VendorItemStatus_OutsidePurchaseWindow:
 		return "OutsidePurchaseWindow"
 	case VendorItemStatus_NotAvailable:
@@ -51931,6 +57404,43 @@ func (e VendorItemStatus) Enum() string {
 	return fmt.Sprintf("VendorItemStatus_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e VendorItemStatus) Valid() bool {
+	switch e {
+	case VendorItemStatus_Success:
+		return true
+	case VendorItemStatus_NoInventorySpace:
+		return true
+	case VendorItemStatus_NoFunds:
+		return true
+	case VendorItemStatus_NoProgression:
+		return true
+	case VendorItemStatus_NoUnlock:
+		return true
+	case VendorItemStatus_NoQuantity:
+		return true
+	case VendorItemStatus_OutsidePurchaseWindow:
+		return true
+	case VendorItemStatus_NotAvailable:
+		return true
+	case VendorItemStatus_UniquenessViolation:
+		return true
+	case VendorItemStatus_UnknownError:
+		return true
+	case VendorItemStatus_AlreadySelling:
+		return true
+	case VendorItemStatus_Unsellable:
+		return true
+	case VendorItemStatus_SellingInhibited:
+		return true
+	case VendorItemStatus_AlreadyOwned:
+		return true
+	case VendorItemStatus_DisplayOnly:
+		return true
+	}
+	return false
+}
+
 func (e PlatformErrorCodes) Enum() string {
 	switch e {
 	case PlatformErrorCodes_None:
@@ -53478,263 +58988,2070 @@ func (e PlatformErrorCodes) Enum() string {
 	case PlatformErrorCodes_ClanFireteamPurchaseRequiredCreate:
 		return "ClanFireteamPurchaseRequiredCreate"
 	case PlatformErrorCodes_ClanFireteamSMSOrPurchaseRequiredJoin:
-		return "ClanFireteamSMSOrPurchaseRequiredJoin"
+		return "ClanFireteamSMSOrPurchaseRequiredJoin"
+	case PlatformErrorCodes_ClanFireteamPurchaseRequiredJoin:
+		return "ClanFireteamPurchaseRequiredJoin"
+	case PlatformErrorCodes_FireteamFinderInvalidMembershipType:
+		return "FireteamFinderInvalidMembershipType"
+	case PlatformErrorCodes_FireteamFinderInvalidMembershipId:
+		return "FireteamFinderInvalidMembershipId"
+	case PlatformErrorCodes_FireteamFinderInvalidCharacterId:
+		return "FireteamFinderInvalidCharacterId"
+	case PlatformErrorCodes_FireteamFinderInvalidListingOptions:
+		return "FireteamFinderInvalidListingOptions"
+	case PlatformErrorCodes_FireteamFinderInvalidRequestData:
+		return "FireteamFinderInvalidRequestData"
+	case PlatformErrorCodes_FireteamFinderListingApplicationFailed:
+		return "FireteamFinderListingApplicationFailed"
+	case PlatformErrorCodes_FireteamFinderListingAutoJoinFailed:
+		return "FireteamFinderListingAutoJoinFailed"
+	case PlatformErrorCodes_FireteamFinderPlayerApplicationsParsingFailed:
+		return "FireteamFinderPlayerApplicationsParsingFailed"
+	case PlatformErrorCodes_FireteamFinderJoinLobbyHostFailed:
+		return "FireteamFinderJoinLobbyHostFailed"
+	case PlatformErrorCodes_FireteamFinderPlayerNotInGame:
+		return "FireteamFinderPlayerNotInGame"
+	case PlatformErrorCodes_FireteamFinderActivationFailed:
+		return "FireteamFinderActivationFailed"
+	case PlatformErrorCodes_FireteamFinderApplicationNotFound:
+		return "FireteamFinderApplicationNotFound"
+	case PlatformErrorCodes_FireteamFinderUserAlreadyAppliedToListing:
+		return "FireteamFinderUserAlreadyAppliedToListing"
+	case PlatformErrorCodes_FireteamFinderApplicationClosedForUpdates:
+		return "FireteamFinderApplicationClosedForUpdates"
+	case PlatformErrorCodes_FireteamFinderListingAtMaxOpenApplicationsLimit:
+		return "FireteamFinderListingAtMaxOpenApplicationsLimit"
+	case PlatformErrorCodes_FireteamFinderUserNotInApplication:
+		return "FireteamFinderUserNotInApplication"
+	case PlatformErrorCodes_FireteamFinderApplicationUserAlreadyListingOwner:
+		return "FireteamFinderApplicationUserAlreadyListingOwner"
+	case PlatformErrorCodes_FireteamFinderOfferNotFound:
+		return "FireteamFinderOfferNotFound"
+	case PlatformErrorCodes_FireteamFinderOfferClosedForUpdates:
+		return "FireteamFinderOfferClosedForUpdates"
+	case PlatformErrorCodes_FireteamFinderOfferUserNotTarget:
+		return "FireteamFinderOfferUserNotTarget"
+	case PlatformErrorCodes_FireteamFinderLobbyNotFound:
+		return "FireteamFinderLobbyNotFound"
+	case PlatformErrorCodes_FireteamFinderListingNotFound:
+		return "FireteamFinderListingNotFound"
+	case PlatformErrorCodes_FireteamFinderLobbyFull:
+		return "FireteamFinderLobbyFull"
+	case PlatformErrorCodes_FireteamFinderUserNotListingOwner:
+		return "FireteamFinderUserNotListingOwner"
+	case PlatformErrorCodes_FireteamFinderUserNotLobbyOwner:
+		return "FireteamFinderUserNotLobbyOwner"
+	case PlatformErrorCodes_FireteamFinderLobbyClosedForUpdates:
+		return "FireteamFinderLobbyClosedForUpdates"
+	case PlatformErrorCodes_FireteamFinderUserNotInLobby:
+		return "FireteamFinderUserNotInLobby"
+	case PlatformErrorCodes_FireteamFinderDisabledSettingsValue:
+		return "FireteamFinderDisabledSettingsValue"
+	case PlatformErrorCodes_FireteamFinderOwnerInActiveLobby:
+		return "FireteamFinderOwnerInActiveLobby"
+	case PlatformErrorCodes_FireteamFinderApplicationClosedToOfflinePlayers:
+		return "FireteamFinderApplicationClosedToOfflinePlayers"
+	case PlatformErrorCodes_FireteamFinderUserNotApplicationOwner:
+		return "FireteamFinderUserNotApplicationOwner"
+	case PlatformErrorCodes_FireteamFinderInviteValidationFailed:
+		return "FireteamFinderInviteValidationFailed"
+	case PlatformErrorCodes_FireteamFinderOwnerNotInGame:
+		return "FireteamFinderOwnerNotInGame"
+	case PlatformErrorCodes_FireteamFinderPlayerAtMaxLobbyLimit:
+		return "FireteamFinderPlayerAtMaxLobbyLimit"
+	case PlatformErrorCodes_FireteamFinderLobbyTooFarInTheFuture:
+		return "FireteamFinderLobbyTooFarInTheFuture"
+	case PlatformErrorCodes_FireteamFinderApplicantNotInGame:
+		return "FireteamFinderApplicantNotInGame"
+	case PlatformErrorCodes_FireteamFinderResponseUndefined:
+		return "FireteamFinderResponseUndefined"
+	case PlatformErrorCodes_FireteamFinderResponseMoved:
+		return "FireteamFinderResponseMoved"
+	case PlatformErrorCodes_FireteamFinderResponseLoggingIn:
+		return "FireteamFinderResponseLoggingIn"
+	case PlatformErrorCodes_FireteamFinderResponseBadRequest:
+		return "FireteamFinderResponseBadRequest"
+	case PlatformErrorCodes_FireteamFinderResponseUnauthorized:
+		return "FireteamFinderResponseUnauthorized"
+	case PlatformErrorCodes_FireteamFinderResponseForbidden:
+		return "FireteamFinderResponseForbidden"
+	case PlatformErrorCodes_FireteamFinderResponseNotFound:
+		return "FireteamFinderResponseNotFound"
+	case PlatformErrorCodes_FireteamFinderInternalServerError:
+		return "FireteamFinderInternalServerError"
+	case PlatformErrorCodes_FireteamFinderServiceUnavailable:
+		return "FireteamFinderServiceUnavailable"
+	case PlatformErrorCodes_FireteamFinderInternalServerErrorNonFatal:
+		return "FireteamFinderInternalServerErrorNonFatal"
+	case PlatformErrorCodes_CrossSaveOverriddenAccountNotFound:
+		return "CrossSaveOverriddenAccountNotFound"
+	case PlatformErrorCodes_CrossSaveTooManyOverriddenPlatforms:
+		return "CrossSaveTooManyOverriddenPlatforms"
+	case PlatformErrorCodes_CrossSaveNoOverriddenPlatforms:
+		return "CrossSaveNoOverriddenPlatforms"
+	case PlatformErrorCodes_CrossSavePrimaryAccountNotFound:
+		return "CrossSavePrimaryAccountNotFound"
+	case PlatformErrorCodes_CrossSaveRequestInvalid:
+		return "CrossSaveRequestInvalid"
+	case PlatformErrorCodes_CrossSaveBungieAccountValidationFailure:
+		return "CrossSaveBungieAccountValidationFailure"
+	case PlatformErrorCodes_CrossSaveOverriddenPlatformNotAllowed:
+		return "CrossSaveOverriddenPlatformNotAllowed"
+	case PlatformErrorCodes_CrossSaveThresholdExceeded:
+		return "CrossSaveThresholdExceeded"
+	case PlatformErrorCodes_CrossSaveIncompatibleMembershipType:
+		return "CrossSaveIncompatibleMembershipType"
+	case PlatformErrorCodes_CrossSaveCouldNotFindLinkedAccountForMembershipType:
+		return "CrossSaveCouldNotFindLinkedAccountForMembershipType"
+	case PlatformErrorCodes_CrossSaveCouldNotCreateDestinyProfileForMembershipType:
+		return "CrossSaveCouldNotCreateDestinyProfileForMembershipType"
+	case PlatformErrorCodes_CrossSaveErrorCreatingDestinyProfileForMembershipType:
+		return "CrossSaveErrorCreatingDestinyProfileForMembershipType"
+	case PlatformErrorCodes_CrossSaveCannotOverrideSelf:
+		return "CrossSaveCannotOverrideSelf"
+	case PlatformErrorCodes_CrossSaveRecentSilverPurchase:
+		return "CrossSaveRecentSilverPurchase"
+	case PlatformErrorCodes_CrossSaveSilverBalanceNegative:
+		return "CrossSaveSilverBalanceNegative"
+	case PlatformErrorCodes_CrossSaveAccountNotAuthenticated:
+		return "CrossSaveAccountNotAuthenticated"
+	case PlatformErrorCodes_ErrorOneAccountAlreadyActive:
+		return "ErrorOneAccountAlreadyActive"
+	case PlatformErrorCodes_ErrorOneAccountDestinyRestriction:
+		return "ErrorOneAccountDestinyRestriction"
+	case PlatformErrorCodes_CrossSaveMustMigrateToSteam:
+		return "CrossSaveMustMigrateToSteam"
+	case PlatformErrorCodes_CrossSaveSteamAlreadyPaired:
+		return "CrossSaveSteamAlreadyPaired"
+	case PlatformErrorCodes_CrossSaveCannotPairJustSteamAndBlizzard:
+		return "CrossSaveCannotPairJustSteamAndBlizzard"
+	case PlatformErrorCodes_CrossSaveCannotPairSteamAloneBeforeShadowkeep:
+		return "CrossSaveCannotPairSteamAloneBeforeShadowkeep"
+	case PlatformErrorCodes_AuthVerificationNotLinkedToAccount:
+		return "AuthVerificationNotLinkedToAccount"
+	case PlatformErrorCodes_PCMigrationMissingBlizzard:
+		return "PCMigrationMissingBlizzard"
+	case PlatformErrorCodes_PCMigrationMissingSteam:
+		return "PCMigrationMissingSteam"
+	case PlatformErrorCodes_PCMigrationInvalidBlizzard:
+		return "PCMigrationInvalidBlizzard"
+	case PlatformErrorCodes_PCMigrationInvalidSteam:
+		return "PCMigrationInvalidSteam"
+	case PlatformErrorCodes_PCMigrationUnknownFailure:
+		return "PCMigrationUnknownFailure"
+	case PlatformErrorCodes_PCMigrationUnknownException:
+		return "PCMigrationUnknownException"
+	case PlatformErrorCodes_PCMigrationNotLinked:
+		return "PCMigrationNotLinked"
+	case PlatformErrorCodes_PCMigrationAccountsAlreadyUsed:
+		return "PCMigrationAccountsAlreadyUsed"
+	case PlatformErrorCodes_PCMigrationStepFailed:
+		return "PCMigrationStepFailed"
+	case PlatformErrorCodes_PCMigrationInvalidBlizzardCrossSaveState:
+		return "PCMigrationInvalidBlizzardCrossSaveState"
+	case PlatformErrorCodes_PCMigrationDestinationBanned:
+		return "PCMigrationDestinationBanned"
+	case PlatformErrorCodes_PCMigrationDestinyFailure:
+		return "PCMigrationDestinyFailure"
+	case PlatformErrorCodes_PCMigrationSilverTransferFailed:
+		return "PCMigrationSilverTransferFailed"
+	case PlatformErrorCodes_PCMigrationEntitlementTransferFailed:
+		return "PCMigrationEntitlementTransferFailed"
+	case PlatformErrorCodes_PCMigrationCannotStompClanFounder:
+		return "PCMigrationCannotStompClanFounder"
+	case PlatformErrorCodes_UnsupportedBrowser:
+		return "UnsupportedBrowser"
+	case PlatformErrorCodes_StadiaAccountRequired:
+		return "StadiaAccountRequired"
+	case PlatformErrorCodes_ErrorPhoneValidationTooManyUses:
+		return "ErrorPhoneValidationTooManyUses"
+	case PlatformErrorCodes_ErrorPhoneValidationNoAssociatedPhone:
+		return "ErrorPhoneValidationNoAssociatedPhone"
+	case PlatformErrorCodes_ErrorPhoneValidationCodeInvalid:
+		return "ErrorPhoneValidationCodeInvalid"
+	case PlatformErrorCodes_ErrorPhoneValidationBanned:
+		return "ErrorPhoneValidationBanned"
+	case PlatformErrorCodes_ErrorPhoneValidationCodeTooRecentlySent:
+		return "ErrorPhoneValidationCodeTooRecentlySent"
+	case PlatformErrorCodes_ErrorPhoneValidationCodeExpired:
+		return "ErrorPhoneValidationCodeExpired"
+	case PlatformErrorCodes_ErrorPhoneValidationInvalidNumberType:
+		return "ErrorPhoneValidationInvalidNumberType"
+	case PlatformErrorCodes_ErrorPhoneValidationCodeTooRecentlyChecked:
+		return "ErrorPhoneValidationCodeTooRecentlyChecked"
+	case PlatformErrorCodes_ErrorPhoneValidationRecentlyPlayedDestiny2AccountRequired:
+		return "ErrorPhoneValidationRecentlyPlayedDestiny2AccountRequired"
+	case PlatformErrorCodes_ApplePushErrorUnknown:
+		return "ApplePushErrorUnknown"
+	case PlatformErrorCodes_ApplePushErrorNull:
+		return "ApplePushErrorNull"
+	case PlatformErrorCodes_ApplePushErrorTimeout:
+		return "ApplePushErrorTimeout"
+	case PlatformErrorCodes_ApplePushBadRequest:
+		return "ApplePushBadRequest"
+	case PlatformErrorCodes_ApplePushFailedAuth:
+		return "ApplePushFailedAuth"
+	case PlatformErrorCodes_ApplePushThrottled:
+		return "ApplePushThrottled"
+	case PlatformErrorCodes_ApplePushServiceUnavailable:
+		return "ApplePushServiceUnavailable"
+	case PlatformErrorCodes_NotAnImageOrVideo:
+		return "NotAnImageOrVideo"
+	case PlatformErrorCodes_ErrorBungieFriendsBlockFailed:
+		return "ErrorBungieFriendsBlockFailed"
+	case PlatformErrorCodes_ErrorBungieFriendsAutoReject:
+		return "ErrorBungieFriendsAutoReject"
+	case PlatformErrorCodes_ErrorBungieFriendsNoRequestFound:
+		return "ErrorBungieFriendsNoRequestFound"
+	case PlatformErrorCodes_ErrorBungieFriendsAlreadyFriends:
+		return "ErrorBungieFriendsAlreadyFriends"
+	case PlatformErrorCodes_ErrorBungieFriendsUnableToRemoveRequest:
+		return "ErrorBungieFriendsUnableToRemoveRequest"
+	case PlatformErrorCodes_ErrorBungieFriendsUnableToRemove:
+		return "ErrorBungieFriendsUnableToRemove"
+	case PlatformErrorCodes_ErrorBungieFriendsIdenticalSourceTarget:
+		return "ErrorBungieFriendsIdenticalSourceTarget"
+	case PlatformErrorCodes_ErrorBungieFriendsSelf:
+		return "ErrorBungieFriendsSelf"
+	case PlatformErrorCodes_ErrorBungieBlockSelf:
+		return "ErrorBungieBlockSelf"
+	case PlatformErrorCodes_ErrorBungieFriendsListFull:
+		return "ErrorBungieFriendsListFull"
+	case PlatformErrorCodes_ErrorBungieBlockListFull:
+		return "ErrorBungieBlockListFull"
+	case PlatformErrorCodes_ErrorBungieFriendNotFound:
+		return "ErrorBungieFriendNotFound"
+	case PlatformErrorCodes_ErrorBungieFriendInvalidMembershipType:
+		return "ErrorBungieFriendInvalidMembershipType"
+	case PlatformErrorCodes_ErrorEgsUnknown:
+		return "ErrorEgsUnknown"
+	case PlatformErrorCodes_ErrorEgsBadRequest:
+		return "ErrorEgsBadRequest"
+	case PlatformErrorCodes_ErrorEgsNotAuthorized:
+		return "ErrorEgsNotAuthorized"
+	case PlatformErrorCodes_ErrorEgsForbidden:
+		return "ErrorEgsForbidden"
+	case PlatformErrorCodes_ErrorEgsAccountNotFound:
+		return "ErrorEgsAccountNotFound"
+	case PlatformErrorCodes_ErrorEgsWebException:
+		return "ErrorEgsWebException"
+	case PlatformErrorCodes_ErrorEgsUnavailable:
+		return "ErrorEgsUnavailable"
+	case PlatformErrorCodes_ErrorEgsJwksMissing:
+		return "ErrorEgsJwksMissing"
+	case PlatformErrorCodes_ErrorEgsJwtMalformedHeader:
+		return "ErrorEgsJwtMalformedHeader"
+	case PlatformErrorCodes_ErrorEgsJwtMalformedPayload:
+		return "ErrorEgsJwtMalformedPayload"
+	}
+	return fmt.Sprintf("PlatformErrorCodes_%d", e)
+}
+
+// Valid reports whether e is one of the values defined by the API.
+func (e PlatformErrorCodes) Valid() bool {
+	switch e {
+	case PlatformErrorCodes_None:
+		return true
+	case PlatformErrorCodes_Success:
+		return true
+	case PlatformErrorCodes_TransportException:
+		return true
+	case PlatformErrorCodes_UnhandledException:
+		return true
+	case PlatformErrorCodes_NotImplemented:
+		return true
+	case PlatformErrorCodes_SystemDisabled:
+		return true
+	case PlatformErrorCodes_FailedToLoadAvailableLocalesConfiguration:
+		return true
+	case PlatformErrorCodes_ParameterParseFailure:
+		return true
+	case PlatformErrorCodes_ParameterInvalidRange:
+		return true
+	case PlatformErrorCodes_BadRequest:
+		return true
+	case PlatformErrorCodes_AuthenticationInvalid:
+		return true
+	case PlatformErrorCodes_DataNotFound:
+		return true
+	case PlatformErrorCodes_InsufficientPrivileges:
+		return true
+	case PlatformErrorCodes_Duplicate:
+		return true
+	case PlatformErrorCodes_UnknownSqlResult:
+		return true
+	case PlatformErrorCodes_ValidationError:
+		return true
+	case PlatformErrorCodes_ValidationMissingFieldError:
+		return true
+	case PlatformErrorCodes_ValidationInvalidInputError:
+		return true
+	case PlatformErrorCodes_InvalidParameters:
+		return true
+	case PlatformErrorCodes_ParameterNotFound:
+		return true
+	case PlatformErrorCodes_UnhandledHttpException:
+		return true
+	case PlatformErrorCodes_NotFound:
+		return true
+	case PlatformErrorCodes_WebAuthModuleAsyncFailed:
+		return true
+	case PlatformErrorCodes_InvalidReturnValue:
+		return true
+	case PlatformErrorCodes_UserBanned:
+		return true
+	case PlatformErrorCodes_InvalidPostBody:
+		return true
+	case PlatformErrorCodes_MissingPostBody:
+		return true
+	case PlatformErrorCodes_ExternalServiceTimeout:
+		return true
+	case PlatformErrorCodes_ValidationLengthError:
+		return true
+	case PlatformErrorCodes_ValidationRangeError:
+		return true
+	case PlatformErrorCodes_JsonDeserializationError:
+		return true
+	case PlatformErrorCodes_ThrottleLimitExceeded:
+		return true
+	case PlatformErrorCodes_ValidationTagError:
+		return true
+	case PlatformErrorCodes_ValidationProfanityError:
+		return true
+	case PlatformErrorCodes_ValidationUrlFormatError:
+		return true
+	case PlatformErrorCodes_ThrottleLimitExceededMinutes:
+		return true
+	case PlatformErrorCodes_ThrottleLimitExceededMomentarily:
+		return true
+	case PlatformErrorCodes_ThrottleLimitExceededSeconds:
+		return true
+	case PlatformErrorCodes_ExternalServiceUnknown:
+		return true
+	case PlatformErrorCodes_ValidationWordLengthError:
+		return true
+	case PlatformErrorCodes_ValidationInvisibleUnicode:
+		return true
+	case PlatformErrorCodes_ValidationBadNames:
+		return true
+	case PlatformErrorCodes_ExternalServiceFailed:
+		return true
+	case PlatformErrorCodes_ServiceRetired:
+		return true
+	case PlatformErrorCodes_UnknownSqlException:
+		return true
+	case PlatformErrorCodes_UnsupportedLocale:
+		return true
+	case PlatformErrorCodes_InvalidPageNumber:
+		return true
+	case PlatformErrorCodes_MaximumPageSizeExceeded:
+		return true
+	case PlatformErrorCodes_ServiceUnsupported:
+		return true
+	case PlatformErrorCodes_ValidationMaximumUnicodeCombiningCharacters:
+		return true
+	case PlatformErrorCodes_ValidationMaximumSequentialCarriageReturns:
+		return true
+	case PlatformErrorCodes_PerEndpointRequestThrottleExceeded:
+		return true
+	case PlatformErrorCodes_AuthContextCacheAssertion:
+		return true
+	case PlatformErrorCodes_ExPlatformStringValidationError:
+		return true
+	case PlatformErrorCodes_PerApplicationThrottleExceeded:
+		return true
+	case PlatformErrorCodes_PerApplicationAnonymousThrottleExceeded:
+		return true
+	case PlatformErrorCodes_PerApplicationAuthenticatedThrottleExceeded:
+		return true
+	case PlatformErrorCodes_PerUserThrottleExceeded:
+		return true
+	case PlatformErrorCodes_PayloadSignatureVerificationFailure:
+		return true
+	case PlatformErrorCodes_InvalidServiceAuthContext:
+		return true
+	case PlatformErrorCodes_FailedMinimumAgeCheck:
+		return true
+	case PlatformErrorCodes_ObsoleteCredentialType:
+		return true
+	case PlatformErrorCodes_UnableToUnPairMobileApp:
+		return true
+	case PlatformErrorCodes_UnableToPairMobileApp:
+		return true
+	case PlatformErrorCodes_CannotUseMobileAuthWithNonMobileProvider:
+		return true
+	case PlatformErrorCodes_MissingDeviceCookie:
+		return true
+	case PlatformErrorCodes_FacebookTokenExpired:
+		return true
+	case PlatformErrorCodes_AuthTicketRequired:
+		return true
+	case PlatformErrorCodes_CookieContextRequired:
+		return true
+	case PlatformErrorCodes_UnknownAuthenticationError:
+		return true
+	case PlatformErrorCodes_BungieNetAccountCreationRequired:
+		return true
+	case PlatformErrorCodes_WebAuthRequired:
+		return true
+	case PlatformErrorCodes_ContentUnknownSqlResult:
+		return true
+	case PlatformErrorCodes_ContentNeedUniquePath:
+		return true
+	case PlatformErrorCodes_ContentSqlException:
+		return true
+	case PlatformErrorCodes_ContentNotFound:
+		return true
+	case PlatformErrorCodes_ContentSuccessWithTagAddFail:
+		return true
+	case PlatformErrorCodes_ContentSearchMissingParameters:
+		return true
+	case PlatformErrorCodes_ContentInvalidId:
+		return true
+	case PlatformErrorCodes_ContentPhysicalFileDeletionError:
+		return true
+	case PlatformErrorCodes_ContentPhysicalFileCreationError:
+		return true
+	case PlatformErrorCodes_ContentPerforceSubmissionError:
+		return true
+	case PlatformErrorCodes_ContentPerforceInitializationError:
+		return true
+	case PlatformErrorCodes_ContentDeploymentPackageNotReadyError:
+		return true
+	case PlatformErrorCodes_ContentUploadFailed:
+		return true
+	case PlatformErrorCodes_ContentTooManyResults:
+		return true
+	case PlatformErrorCodes_ContentInvalidState:
+		return true
+	case PlatformErrorCodes_ContentNavigationParentNotFound:
+		return true
+	case PlatformErrorCodes_ContentNavigationParentUpdateError:
+		return true
+	case PlatformErrorCodes_DeploymentPackageNotEditable:
+		return true
+	case PlatformErrorCodes_ContentValidationError:
+		return true
+	case PlatformErrorCodes_ContentPropertiesValidationError:
+		return true
+	case PlatformErrorCodes_ContentTypeNotFound:
+		return true
+	case PlatformErrorCodes_DeploymentPackageNotFound:
+		return true
+	case PlatformErrorCodes_ContentSearchInvalidParameters:
+		return true
+	case PlatformErrorCodes_ContentItemPropertyAggregationError:
+		return true
+	case PlatformErrorCodes_DeploymentPackageFileNotFound:
+		return true
+	case PlatformErrorCodes_ContentPerforceFileHistoryNotFound:
+		return true
+	case PlatformErrorCodes_ContentAssetZipCreationFailure:
+		return true
+	case PlatformErrorCodes_ContentAssetZipCreationBusy:
+		return true
+	case PlatformErrorCodes_ContentProjectNotFound:
+		return true
+	case PlatformErrorCodes_ContentFolderNotFound:
+		return true
+	case PlatformErrorCodes_ContentPackagesInconsistent:
+		return true
+	case PlatformErrorCodes_ContentPackagesInvalidState:
+		return true
+	case PlatformErrorCodes_ContentPackagesInconsistentType:
+		return true
+	case PlatformErrorCodes_ContentCannotDeletePackage:
+		return true
+	case PlatformErrorCodes_ContentLockedForChanges:
+		return true
+	case PlatformErrorCodes_ContentFileUploadFailed:
+		return true
+	case PlatformErrorCodes_ContentNotReviewed:
+		return true
+	case PlatformErrorCodes_ContentPermissionDenied:
+		return true
+	case PlatformErrorCodes_ContentInvalidExternalUrl:
+		return true
+	case PlatformErrorCodes_ContentExternalFileCannotBeImportedLocally:
+		return true
+	case PlatformErrorCodes_ContentTagSaveFailure:
+		return true
+	case PlatformErrorCodes_ContentPerforceUnmatchedFileError:
+		return true
+	case PlatformErrorCodes_ContentPerforceChangelistResultNotFound:
+		return true
+	case PlatformErrorCodes_ContentPerforceChangelistFileItemsNotFound:
+		return true
+	case PlatformErrorCodes_ContentPerforceInvalidRevisionError:
+		return true
+	case PlatformErrorCodes_ContentUnloadedSaveResult:
+		return true
+	case PlatformErrorCodes_ContentPropertyInvalidNumber:
+		return true
+	case PlatformErrorCodes_ContentPropertyInvalidUrl:
+		return true
+	case PlatformErrorCodes_ContentPropertyInvalidDate:
+		return true
+	case PlatformErrorCodes_ContentPropertyInvalidSet:
+		return true
+	case PlatformErrorCodes_ContentPropertyCannotDeserialize:
+		return true
+	case PlatformErrorCodes_ContentRegexValidationFailOnProperty:
+		return true
+	case PlatformErrorCodes_ContentMaxLengthFailOnProperty:
+		return true
+	case PlatformErrorCodes_ContentPropertyUnexpectedDeserializationError:
+		return true
+	case PlatformErrorCodes_ContentPropertyRequired:
+		return true
+	case PlatformErrorCodes_ContentCannotCreateFile:
+		return true
+	case PlatformErrorCodes_ContentInvalidMigrationFile:
+		return true
+	case PlatformErrorCodes_ContentMigrationAlteringProcessedItem:
+		return true
+	case PlatformErrorCodes_ContentPropertyDefinitionNotFound:
+		return true
+	case PlatformErrorCodes_ContentReviewDataChanged:
+		return true
+	case PlatformErrorCodes_ContentRollbackRevisionNotInPackage:
+		return true
+	case PlatformErrorCodes_ContentItemNotBasedOnLatestRevision:
+		return true
+	case PlatformErrorCodes_ContentUnauthorized:
+		return true
+	case PlatformErrorCodes_ContentCannotCreateDeploymentPackage:
+		return true
+	case PlatformErrorCodes_ContentUserNotFound:
+		return true
+	case PlatformErrorCodes_ContentLocalePermissionDenied:
+		return true
+	case PlatformErrorCodes_ContentInvalidLinkToInternalEnvironment:
+		return true
+	case PlatformErrorCodes_ContentInvalidBlacklistedContent:
+		return true
+	case PlatformErrorCodes_ContentMacroMalformedNoContentId:
+		return true
+	case PlatformErrorCodes_ContentMacroMalformedNoTemplateType:
+		return true
+	case PlatformErrorCodes_ContentIllegalBNetMembershipId:
+		return true
+	case PlatformErrorCodes_ContentLocaleDidNotMatchExpected:
+		return true
+	case PlatformErrorCodes_ContentBabelCallFailed:
+		return true
+	case PlatformErrorCodes_ContentEnglishPostLiveForbidden:
+		return true
+	case PlatformErrorCodes_ContentLocaleEditPermissionDenied:
+		return true
+	case PlatformErrorCodes_ContentStackUnknownError:
+		return true
+	case PlatformErrorCodes_ContentStackNotFound:
+		return true
+	case PlatformErrorCodes_ContentStackRateLimited:
+		return true
+	case PlatformErrorCodes_ContentStackTimeout:
+		return true
+	case PlatformErrorCodes_ContentStackServiceError:
+		return true
+	case PlatformErrorCodes_ContentStackDeserializationFailure:
+		return true
+	case PlatformErrorCodes_UserNonUniqueName:
+		return true
+	case PlatformErrorCodes_UserManualLinkingStepRequired:
+		return true
+	case PlatformErrorCodes_UserCreateUnknownSqlResult:
+		return true
+	case PlatformErrorCodes_UserCreateUnknownSqlException:
+		return true
+	case PlatformErrorCodes_UserMalformedMembershipId:
+		return true
+	case PlatformErrorCodes_UserCannotFindRequestedUser:
+		return true
+	case PlatformErrorCodes_UserCannotLoadAccountCredentialLinkInfo:
+		return true
+	case PlatformErrorCodes_UserInvalidMobileAppType:
+		return true
+	case PlatformErrorCodes_UserMissingMobilePairingInfo:
+		return true
+	case PlatformErrorCodes_UserCannotGenerateMobileKeyWhileUsingMobileCredential:
+		return true
+	case PlatformErrorCodes_UserGenerateMobileKeyExistingSlotCollision:
+		return true
+	case PlatformErrorCodes_UserDisplayNameMissingOrInvalid:
+		return true
+	case PlatformErrorCodes_UserCannotLoadAccountProfileData:
+		return true
+	case PlatformErrorCodes_UserCannotSaveUserProfileData:
+		return true
+	case PlatformErrorCodes_UserEmailMissingOrInvalid:
+		return true
+	case PlatformErrorCodes_UserTermsOfUseRequired:
+		return true
+	case PlatformErrorCodes_UserCannotCreateNewAccountWhileLoggedIn:
+		return true
+	case PlatformErrorCodes_UserCannotResolveCentralAccount:
+		return true
+	case PlatformErrorCodes_UserInvalidAvatar:
+		return true
+	case PlatformErrorCodes_UserMissingCreatedUserResult:
+		return true
+	case PlatformErrorCodes_UserCannotChangeUniqueNameYet:
+		return true
+	case PlatformErrorCodes_UserCannotChangeDisplayNameYet:
+		return true
+	case PlatformErrorCodes_UserCannotChangeEmail:
+		return true
+	case PlatformErrorCodes_UserUniqueNameMustStartWithLetter:
+		return true
+	case PlatformErrorCodes_UserNoLinkedAccountsSupportFriendListings:
+		return true
+	case PlatformErrorCodes_UserAcknowledgmentTableFull:
+		return true
+	case PlatformErrorCodes_UserCreationDestinyMembershipRequired:
+		return true
+	case PlatformErrorCodes_UserFriendsTokenNeedsRefresh:
+		return true
+	case PlatformErrorCodes_UserEmailValidationUnknown:
+		return true
+	case PlatformErrorCodes_UserEmailValidationLimit:
+		return true
+	case PlatformErrorCodes_TransactionEmailSendFailure:
+		return true
+	case PlatformErrorCodes_MailHookPermissionFailure:
+		return true
+	case PlatformErrorCodes_MailServiceRateLimit:
+		return true
+	case PlatformErrorCodes_UserEmailMustBeVerified:
+		return true
+	case PlatformErrorCodes_UserMustAllowCustomerServiceEmails:
+		return true
+	case PlatformErrorCodes_NonTransactionalEmailSendFailure:
+		return true
+	case PlatformErrorCodes_UnknownErrorSettingGlobalDisplayName:
+		return true
+	case PlatformErrorCodes_DuplicateGlobalDisplayName:
+		return true
+	case PlatformErrorCodes_ErrorRunningNameValidationChecks:
+		return true
+	case PlatformErrorCodes_ErrorDatabaseGlobalName:
+		return true
+	case PlatformErrorCodes_ErrorNoAvailableNameChanges:
+		return true
+	case PlatformErrorCodes_ErrorNameAlreadySetToInput:
+		return true
+	case PlatformErrorCodes_UserDisplayNameLessThanMinLength:
+		return true
+	case PlatformErrorCodes_UserDisplayNameGreaterThanMaxLength:
+		return true
+	case PlatformErrorCodes_UserDisplayNameContainsUnacceptableOrInvalidContent:
+		return true
+	case PlatformErrorCodes_EmailValidationOffline:
+		return true
+	case PlatformErrorCodes_EmailValidationFailOldCode:
+		return true
+	case PlatformErrorCodes_EmailValidationFailBadLink:
+		return true
+	case PlatformErrorCodes_EmailUnsubscribeFail:
+		return true
+	case PlatformErrorCodes_EmailUnsubscribeFailNew:
+		return true
+	case PlatformErrorCodes_MessagingUnknownError:
+		return true
+	case PlatformErrorCodes_MessagingSelfError:
+		return true
+	case PlatformErrorCodes_MessagingSendThrottle:
+		return true
+	case PlatformErrorCodes_MessagingNoBody:
+		return true
+	case PlatformErrorCodes_MessagingTooManyUsers:
+		return true
+	case PlatformErrorCodes_MessagingCanNotLeaveConversation:
+		return true
+	case PlatformErrorCodes_MessagingUnableToSend:
+		return true
+	case PlatformErrorCodes_MessagingDeletedUserForbidden:
+		return true
+	case PlatformErrorCodes_MessagingCannotDeleteExternalConversation:
+		return true
+	case PlatformErrorCodes_MessagingGroupChatDisabled:
+		return true
+	case PlatformErrorCodes_MessagingMustIncludeSelfInPrivateMessage:
+		return true
+	case PlatformErrorCodes_MessagingSenderIsBanned:
+		return true
+	case PlatformErrorCodes_MessagingGroupOptionalChatExceededMaximum:
+		return true
+	case PlatformErrorCodes_PrivateMessagingRequiresDestinyMembership:
+		return true
+	case PlatformErrorCodes_MessagingSendDailyThrottle:
+		return true
+	case PlatformErrorCodes_AddSurveyAnswersUnknownSqlException:
+		return true
+	case PlatformErrorCodes_ForumBodyCannotBeEmpty:
+		return true
+	case PlatformErrorCodes_ForumSubjectCannotBeEmptyOnTopicPost:
+		return true
+	case PlatformErrorCodes_ForumCannotLocateParentPost:
+		return true
+	case PlatformErrorCodes_ForumThreadLockedForReplies:
+		return true
+	case PlatformErrorCodes_ForumUnknownSqlResultDuringCreatePost:
+		return true
+	case PlatformErrorCodes_ForumUnknownTagCreationError:
+		return true
+	case PlatformErrorCodes_ForumUnknownSqlResultDuringTagItem:
+		return true
+	case PlatformErrorCodes_ForumUnknownExceptionCreatePost:
+		return true
+	case PlatformErrorCodes_ForumQuestionMustBeTopicPost:
+		return true
+	case PlatformErrorCodes_ForumExceptionDuringTagSearch:
+		return true
+	case PlatformErrorCodes_ForumExceptionDuringTopicRetrieval:
+		return true
+	case PlatformErrorCodes_ForumAliasedTagError:
+		return true
+	case PlatformErrorCodes_ForumCannotLocateThread:
+		return true
+	case PlatformErrorCodes_ForumUnknownExceptionEditPost:
+		return true
+	case PlatformErrorCodes_ForumCannotLocatePost:
+		return true
+	case PlatformErrorCodes_ForumUnknownExceptionGetOrCreateTags:
+		return true
+	case PlatformErrorCodes_ForumEditPermissionDenied:
+		return true
+	case PlatformErrorCodes_ForumUnknownSqlResultDuringTagIdRetrieval:
+		return true
+	case PlatformErrorCodes_ForumCannotGetRating:
+		return true
+	case PlatformErrorCodes_ForumUnknownExceptionGetRating:
+		return true
+	case PlatformErrorCodes_ForumRatingsAccessError:
+		return true
+	case PlatformErrorCodes_ForumRelatedPostAccessError:
+		return true
+	case PlatformErrorCodes_ForumLatestReplyAccessError:
+		return true
+	case PlatformErrorCodes_ForumUserStatusAccessError:
+		return true
+	case PlatformErrorCodes_ForumAuthorAccessError:
+		return true
+	case PlatformErrorCodes_ForumGroupAccessError:
+		return true
+	case PlatformErrorCodes_ForumUrlExpectedButMissing:
+		return true
+	case PlatformErrorCodes_ForumRepliesCannotBeEmpty:
+		return true
+	case PlatformErrorCodes_ForumRepliesCannotBeInDifferentGroups:
+		return true
+	case PlatformErrorCodes_ForumSubTopicCannotBeCreatedAtThisThreadLevel:
+		return true
+	case PlatformErrorCodes_ForumCannotCreateContentTopic:
+		return true
+	case PlatformErrorCodes_ForumTopicDoesNotExist:
+		return true
+	case PlatformErrorCodes_ForumContentCommentsNotAllowed:
+		return true
+	case PlatformErrorCodes_ForumUnknownSqlResultDuringEditPost:
+		return true
+	case PlatformErrorCodes_ForumUnknownSqlResultDuringGetPost:
+		return true
+	case PlatformErrorCodes_ForumPostValidationBadUrl:
+		return true
+	case PlatformErrorCodes_ForumBodyTooLong:
+		return true
+	case PlatformErrorCodes_ForumSubjectTooLong:
+		return true
+	case PlatformErrorCodes_ForumAnnouncementNotAllowed:
+		return true
+	case PlatformErrorCodes_ForumCannotShareOwnPost:
+		return true
+	case PlatformErrorCodes_ForumEditNoOp:
+		return true
+	case PlatformErrorCodes_ForumUnknownDatabaseErrorDuringGetPost:
+		return true
+	case PlatformErrorCodes_ForumExceeedMaximumRowLimit:
+		return true
+	case PlatformErrorCodes_ForumCannotSharePrivatePost:
+		return true
+	case PlatformErrorCodes_ForumCannotCrossPostBetweenGroups:
+		return true
+	case PlatformErrorCodes_ForumIncompatibleCategories:
+		return true
+	case PlatformErrorCodes_ForumCannotUseTheseCategoriesOnNonTopicPost:
+		return true
+	case PlatformErrorCodes_ForumCanOnlyDeleteTopics:
+		return true
+	case PlatformErrorCodes_ForumDeleteSqlException:
+		return true
+	case PlatformErrorCodes_ForumDeleteSqlUnknownResult:
+		return true
+	case PlatformErrorCodes_ForumTooManyTags:
+		return true
+	case PlatformErrorCodes_ForumCanOnlyRateTopics:
+		return true
+	case PlatformErrorCodes_ForumBannedPostsCannotBeEdited:
+		return true
+	case PlatformErrorCodes_ForumThreadRootIsBanned:
+		return true
+	case PlatformErrorCodes_ForumCannotUseOfficialTagCategoryAsTag:
+		return true
+	case PlatformErrorCodes_ForumAnswerCannotBeMadeOnCreatePost:
+		return true
+	case PlatformErrorCodes_ForumAnswerCannotBeMadeOnEditPost:
+		return true
+	case PlatformErrorCodes_ForumAnswerPostIdIsNotADirectReplyOfQuestion:
+		return true
+	case PlatformErrorCodes_ForumAnswerTopicIdIsNotAQuestion:
+		return true
+	case PlatformErrorCodes_ForumUnknownExceptionDuringMarkAnswer:
+		return true
+	case PlatformErrorCodes_ForumUnknownSqlResultDuringMarkAnswer:
+		return true
+	case PlatformErrorCodes_ForumCannotRateYourOwnPosts:
+		return true
+	case PlatformErrorCodes_ForumPollsMustBeTheFirstPostInTopic:
+		return true
+	case PlatformErrorCodes_ForumInvalidPollInput:
+		return true
+	case PlatformErrorCodes_ForumGroupAdminEditNonMember:
+		return true
+	case PlatformErrorCodes_ForumCannotEditModeratorEditedPost:
+		return true
+	case PlatformErrorCodes_ForumRequiresDestinyMembership:
+		return true
+	case PlatformErrorCodes_ForumUnexpectedError:
+		return true
+	case PlatformErrorCodes_ForumAgeLock:
+		return true
+	case PlatformErrorCodes_ForumMaxPages:
+		return true
+	case PlatformErrorCodes_ForumMaxPagesOldestFirst:
+		return true
+	case PlatformErrorCodes_ForumCannotApplyForumIdWithoutTags:
+		return true
+	case PlatformErrorCodes_ForumCannotApplyForumIdToNonTopics:
+		return true
+	case PlatformErrorCodes_ForumCannotDownvoteCommunityCreations:
+		return true
+	case PlatformErrorCodes_ForumTopicsMustHaveOfficialCategory:
+		return true
+	case PlatformErrorCodes_ForumRecruitmentTopicMalformed:
+		return true
+	case PlatformErrorCodes_ForumRecruitmentTopicNotFound:
+		return true
+	case PlatformErrorCodes_ForumRecruitmentTopicNoSlotsRemaining:
+		return true
+	case PlatformErrorCodes_ForumRecruitmentTopicKickBan:
+		return true
+	case PlatformErrorCodes_ForumRecruitmentTopicRequirementsNotMet:
+		return true
+	case PlatformErrorCodes_ForumRecruitmentTopicNoPlayers:
+		return true
+	case PlatformErrorCodes_ForumRecruitmentApproveFailMessageBan:
+		return true
+	case PlatformErrorCodes_ForumRecruitmentGlobalBan:
+		return true
+	case PlatformErrorCodes_ForumUserBannedFromThisTopic:
+		return true
+	case PlatformErrorCodes_ForumRecruitmentFireteamMembersOnly:
+		return true
+	case PlatformErrorCodes_ForumRequiresDestiny2Progress:
+		return true
+	case PlatformErrorCodes_ForumRequiresDestiny2EntitlementPurchase:
+		return true
+	case PlatformErrorCodes_GroupMembershipApplicationAlreadyResolved:
+		return true
+	case PlatformErrorCodes_GroupMembershipAlreadyApplied:
+		return true
+	case PlatformErrorCodes_GroupMembershipInsufficientPrivileges:
+		return true
+	case PlatformErrorCodes_GroupIdNotReturnedFromCreation:
+		return true
+	case PlatformErrorCodes_GroupSearchInvalidParameters:
+		return true
+	case PlatformErrorCodes_GroupMembershipPendingApplicationNotFound:
+		return true
+	case PlatformErrorCodes_GroupInvalidId:
+		return true
+	case PlatformErrorCodes_GroupInvalidMembershipId:
+		return true
+	case PlatformErrorCodes_GroupInvalidMembershipType:
+		return true
+	case PlatformErrorCodes_GroupMissingTags:
+		return true
+	case PlatformErrorCodes_GroupMembershipNotFound:
+		return true
+	case PlatformErrorCodes_GroupInvalidRating:
+		return true
+	case PlatformErrorCodes_GroupUserFollowingAccessError:
+		return true
+	case PlatformErrorCodes_GroupUserMembershipAccessError:
+		return true
+	case PlatformErrorCodes_GroupCreatorAccessError:
+		return true
+	case PlatformErrorCodes_GroupAdminAccessError:
+		return true
+	case PlatformErrorCodes_GroupPrivatePostNotViewable:
+		return true
+	case PlatformErrorCodes_GroupMembershipNotLoggedIn:
+		return true
+	case PlatformErrorCodes_GroupNotDeleted:
+		return true
+	case PlatformErrorCodes_GroupUnknownErrorUndeletingGroup:
+		return true
+	case PlatformErrorCodes_GroupDeleted:
+		return true
+	case PlatformErrorCodes_GroupNotFound:
+		return true
+	case PlatformErrorCodes_GroupMemberBanned:
+		return true
+	case PlatformErrorCodes_GroupMembershipClosed:
+		return true
+	case PlatformErrorCodes_GroupPrivatePostOverrideError:
+		return true
+	case PlatformErrorCodes_GroupNameTaken:
+		return true
+	case PlatformErrorCodes_GroupDeletionGracePeriodExpired:
+		return true
+	case PlatformErrorCodes_GroupCannotCheckBanStatus:
+		return true
+	case PlatformErrorCodes_GroupMaximumMembershipCountReached:
+		return true
+	case PlatformErrorCodes_NoDestinyAccountForClanPlatform:
+		return true
+	case PlatformErrorCodes_AlreadyRequestingMembershipForClanPlatform:
+		return true
+	case PlatformErrorCodes_AlreadyClanMemberOnPlatform:
+		return true
+	case PlatformErrorCodes_GroupJoinedCannotSetClanName:
+		return true
+	case PlatformErrorCodes_GroupLeftCannotClearClanName:
+		return true
+	case PlatformErrorCodes_GroupRelationshipRequestPending:
+		return true
+	case PlatformErrorCodes_GroupRelationshipRequestBlocked:
+		return true
+	case PlatformErrorCodes_GroupRelationshipRequestNotFound:
+		return true
+	case PlatformErrorCodes_GroupRelationshipBlockNotFound:
+		return true
+	case PlatformErrorCodes_GroupRelationshipNotFound:
+		return true
+	case PlatformErrorCodes_GroupAlreadyAllied:
+		return true
+	case PlatformErrorCodes_GroupAlreadyMember:
+		return true
+	case PlatformErrorCodes_GroupRelationshipAlreadyExists:
+		return true
+	case PlatformErrorCodes_InvalidGroupTypesForRelationshipRequest:
+		return true
+	case PlatformErrorCodes_GroupAtMaximumAlliances:
+		return true
+	case PlatformErrorCodes_GroupCannotSetClanOnlySettings:
+		return true
+	case PlatformErrorCodes_ClanCannotSetTwoDefaultPostTypes:
+		return true
+	case PlatformErrorCodes_GroupMemberInvalidMemberType:
+		return true
+	case PlatformErrorCodes_GroupInvalidPlatformType:
+		return true
+	case PlatformErrorCodes_GroupMemberInvalidSort:
+		return true
+	case PlatformErrorCodes_GroupInvalidResolveState:
+		return true
+	case PlatformErrorCodes_ClanAlreadyEnabledForPlatform:
+		return true
+	case PlatformErrorCodes_ClanNotEnabledForPlatform:
+		return true
+	case PlatformErrorCodes_ClanEnabledButCouldNotJoinNoAccount:
+		return true
+	case PlatformErrorCodes_ClanEnabledButCouldNotJoinAlreadyMember:
+		return true
+	case PlatformErrorCodes_ClanCannotJoinNoCredential:
+		return true
+	case PlatformErrorCodes_NoClanMembershipForPlatform:
+		return true
+	case PlatformErrorCodes_GroupToGroupFollowLimitReached:
+		return true
+	case PlatformErrorCodes_ChildGroupAlreadyInAlliance:
+		return true
+	case PlatformErrorCodes_OwnerGroupAlreadyInAlliance:
+		return true
+	case PlatformErrorCodes_AllianceOwnerCannotJoinAlliance:
+		return true
+	case PlatformErrorCodes_GroupNotInAlliance:
+		return true
+	case PlatformErrorCodes_ChildGroupCannotInviteToAlliance:
+		return true
+	case PlatformErrorCodes_GroupToGroupAlreadyFollowed:
+		return true
+	case PlatformErrorCodes_GroupToGroupNotFollowing:
+		return true
+	case PlatformErrorCodes_ClanMaximumMembershipReached:
+		return true
+	case PlatformErrorCodes_ClanNameNotValid:
+		return true
+	case PlatformErrorCodes_ClanNameNotValidError:
+		return true
+	case PlatformErrorCodes_AllianceOwnerNotDefined:
+		return true
+	case PlatformErrorCodes_AllianceChildNotDefined:
+		return true
+	case PlatformErrorCodes_ClanCultureIllegalCharacters:
+		return true
+	case PlatformErrorCodes_ClanTagIllegalCharacters:
+		return true
+	case PlatformErrorCodes_ClanRequiresInvitation:
+		return true
+	case PlatformErrorCodes_ClanMembershipClosed:
+		return true
+	case PlatformErrorCodes_ClanInviteAlreadyMember:
+		return true
+	case PlatformErrorCodes_GroupInviteAlreadyMember:
+		return true
+	case PlatformErrorCodes_GroupJoinApprovalRequired:
+		return true
+	case PlatformErrorCodes_ClanTagRequired:
+		return true
+	case PlatformErrorCodes_GroupNameCannotStartOrEndWithWhiteSpace:
+		return true
+	case PlatformErrorCodes_ClanCallsignCannotStartOrEndWithWhiteSpace:
+		return true
+	case PlatformErrorCodes_ClanMigrationFailed:
+		return true
+	case PlatformErrorCodes_ClanNotEnabledAlreadyMemberOfAnotherClan:
+		return true
+	case PlatformErrorCodes_GroupModerationNotPermittedOnNonMembers:
+		return true
+	case PlatformErrorCodes_ClanCreationInWorldServerFailed:
+		return true
+	case PlatformErrorCodes_ClanNotFound:
+		return true
+	case PlatformErrorCodes_ClanMembershipLevelDoesNotPermitThatAction:
+		return true
+	case PlatformErrorCodes_ClanMemberNotFound:
+		return true
+	case PlatformErrorCodes_ClanMissingMembershipApprovers:
+		return true
+	case PlatformErrorCodes_ClanInWrongStateForRequestedAction:
+		return true
+	case PlatformErrorCodes_ClanNameAlreadyUsed:
+		return true
+	case PlatformErrorCodes_ClanTooFewMembers:
+		return true
+	case PlatformErrorCodes_ClanInfoCannotBeWhitespace:
+		return true
+	case PlatformErrorCodes_GroupCultureThrottle:
+		return true
+	case PlatformErrorCodes_ClanTargetDisallowsInvites:
+		return true
+	case PlatformErrorCodes_ClanInvalidOperation:
+		return true
+	case PlatformErrorCodes_ClanFounderCannotLeaveWithoutAbdication:
+		return true
+	case PlatformErrorCodes_ClanNameReserved:
+		return true
+	case PlatformErrorCodes_ClanApplicantInClanSoNowInvited:
+		return true
+	case PlatformErrorCodes_ActivitiesUnknownException:
+		return true
+	case PlatformErrorCodes_ActivitiesParameterNull:
+		return true
+	case PlatformErrorCodes_ActivityCountsDiabled:
+		return true
+	case PlatformErrorCodes_ActivitySearchInvalidParameters:
+		return true
+	case PlatformErrorCodes_ActivityPermissionDenied:
+		return true
+	case PlatformErrorCodes_ShareAlreadyShared:
+		return true
+	case PlatformErrorCodes_ActivityLoggingDisabled:
+		return true
+	case PlatformErrorCodes_ClanRequiresExistingDestinyAccount:
+		return true
+	case PlatformErrorCodes_ClanNameRestricted:
+		return true
+	case PlatformErrorCodes_ClanCreationBan:
+		return true
+	case PlatformErrorCodes_ClanCreationTenureRequirementsNotMet:
+		return true
+	case PlatformErrorCodes_ClanFieldContainsReservedTerms:
+		return true
+	case PlatformErrorCodes_ClanFieldContainsInappropriateContent:
+		return true
+	case PlatformErrorCodes_ItemAlreadyFollowed:
+		return true
+	case PlatformErrorCodes_ItemNotFollowed:
+		return true
+	case PlatformErrorCodes_CannotFollowSelf:
+		return true
+	case PlatformErrorCodes_GroupFollowLimitExceeded:
+		return true
+	case PlatformErrorCodes_TagFollowLimitExceeded:
+		return true
+	case PlatformErrorCodes_UserFollowLimitExceeded:
+		return true
+	case PlatformErrorCodes_FollowUnsupportedEntityType:
+		return true
+	case PlatformErrorCodes_NoValidTagsInList:
+		return true
+	case PlatformErrorCodes_BelowMinimumSuggestionLength:
+		return true
+	case PlatformErrorCodes_CannotGetSuggestionsOnMultipleTagsSimultaneously:
+		return true
+	case PlatformErrorCodes_NotAValidPartialTag:
+		return true
+	case PlatformErrorCodes_TagSuggestionsUnknownSqlResult:
+		return true
+	case PlatformErrorCodes_TagsUnableToLoadPopularTagsFromDatabase:
+		return true
+	case PlatformErrorCodes_TagInvalid:
+		return true
+	case PlatformErrorCodes_TagNotFound:
+		return true
+	case PlatformErrorCodes_SingleTagExpected:
+		return true
+	case PlatformErrorCodes_TagsExceededMaximumPerItem:
+		return true
+	case PlatformErrorCodes_IgnoreInvalidParameters:
+		return true
+	case PlatformErrorCodes_IgnoreSqlException:
+		return true
+	case PlatformErrorCodes_IgnoreErrorRetrievingGroupPermissions:
+		return true
+	case PlatformErrorCodes_IgnoreErrorInsufficientPermission:
+		return true
+	case PlatformErrorCodes_IgnoreErrorRetrievingItem:
+		return true
+	case PlatformErrorCodes_IgnoreCannotIgnoreSelf:
+		return true
+	case PlatformErrorCodes_IgnoreIllegalType:
+		return true
+	case PlatformErrorCodes_IgnoreNotFound:
+		return true
+	case PlatformErrorCodes_IgnoreUserGloballyIgnored:
+		return true
+	case PlatformErrorCodes_IgnoreUserIgnored:
+		return true
+	case PlatformErrorCodes_TargetUserIgnored:
+		return true
+	case PlatformErrorCodes_NotificationSettingInvalid:
+		return true
+	case PlatformErrorCodes_PsnApiExpiredAccessToken:
+		return true
+	case PlatformErrorCodes_PSNExForbidden:
+		return true
+	case PlatformErrorCodes_PSNExSystemDisabled:
+		return true
+	case PlatformErrorCodes_PsnApiErrorCodeUnknown:
+		return true
+	case PlatformErrorCodes_PsnApiErrorWebException:
+		return true
+	case PlatformErrorCodes_PsnApiBadRequest:
+		return true
+	case PlatformErrorCodes_PsnApiAccessTokenRequired:
+		return true
+	case PlatformErrorCodes_PsnApiInvalidAccessToken:
+		return true
+	case PlatformErrorCodes_PsnApiBannedUser:
+		return true
+	case PlatformErrorCodes_PsnApiAccountUpgradeRequired:
+		return true
+	case PlatformErrorCodes_PsnApiServiceTemporarilyUnavailable:
+		return true
+	case PlatformErrorCodes_PsnApiServerBusy:
+		return true
+	case PlatformErrorCodes_PsnApiUnderMaintenance:
+		return true
+	case PlatformErrorCodes_PsnApiProfileUserNotFound:
+		return true
+	case PlatformErrorCodes_PsnApiProfilePrivacyRestriction:
+		return true
+	case PlatformErrorCodes_PsnApiProfileUnderMaintenance:
+		return true
+	case PlatformErrorCodes_PsnApiAccountAttributeMissing:
+		return true
+	case PlatformErrorCodes_PsnApiNoPermission:
+		return true
+	case PlatformErrorCodes_PsnApiTargetUserBlocked:
+		return true
+	case PlatformErrorCodes_PsnApiJwksMissing:
+		return true
+	case PlatformErrorCodes_PsnApiJwtMalformedHeader:
+		return true
+	case PlatformErrorCodes_PsnApiJwtMalformedPayload:
+		return true
+	case PlatformErrorCodes_XblExSystemDisabled:
+		return true
+	case PlatformErrorCodes_XblExUnknownError:
+		return true
+	case PlatformErrorCodes_XblApiErrorWebException:
+		return true
+	case PlatformErrorCodes_XblStsTokenInvalid:
+		return true
+	case PlatformErrorCodes_XblStsMissingToken:
+		return true
+	case PlatformErrorCodes_XblStsExpiredToken:
+		return true
+	case PlatformErrorCodes_XblAccessToTheSandboxDenied:
+		return true
+	case PlatformErrorCodes_XblMsaResponseMissing:
+		return true
+	case PlatformErrorCodes_XblMsaAccessTokenExpired:
+		return true
+	case PlatformErrorCodes_XblMsaInvalidRequest:
+		return true
+	case PlatformErrorCodes_XblMsaFriendsRequireSignIn:
+		return true
+	case PlatformErrorCodes_XblUserActionRequired:
+		return true
+	case PlatformErrorCodes_XblParentalControls:
+		return true
+	case PlatformErrorCodes_XblDeveloperAccount:
+		return true
+	case PlatformErrorCodes_XblUserTokenExpired:
+		return true
+	case PlatformErrorCodes_XblUserTokenInvalid:
+		return true
+	case PlatformErrorCodes_XblOffline:
+		return true
+	case PlatformErrorCodes_XblUnknownErrorCode:
+		return true
+	case PlatformErrorCodes_XblMsaInvalidGrant:
+		return true
+	case PlatformErrorCodes_ReportNotYetResolved:
+		return true
+	case PlatformErrorCodes_ReportOverturnDoesNotChangeDecision:
+		return true
+	case PlatformErrorCodes_ReportNotFound:
+		return true
+	case PlatformErrorCodes_ReportAlreadyReported:
+		return true
+	case PlatformErrorCodes_ReportInvalidResolution:
+		return true
+	case PlatformErrorCodes_ReportNotAssignedToYou:
+		return true
+	case PlatformErrorCodes_LegacyGameStatsSystemDisabled:
+		return true
+	case PlatformErrorCodes_LegacyGameStatsUnknownError:
+		return true
+	case PlatformErrorCodes_LegacyGameStatsMalformedSneakerNetCode:
+		return true
+	case PlatformErrorCodes_DestinyAccountAcquisitionFailure:
+		return true
+	case PlatformErrorCodes_DestinyAccountNotFound:
+		return true
+	case PlatformErrorCodes_DestinyBuildStatsDatabaseError:
+		return true
+	case PlatformErrorCodes_DestinyCharacterStatsDatabaseError:
+		return true
+	case PlatformErrorCodes_DestinyPvPStatsDatabaseError:
+		return true
+	case PlatformErrorCodes_DestinyPvEStatsDatabaseError:
+		return true
+	case PlatformErrorCodes_DestinyGrimoireStatsDatabaseError:
+		return true
+	case PlatformErrorCodes_DestinyStatsParameterMembershipTypeParseError:
+		return true
+	case PlatformErrorCodes_DestinyStatsParameterMembershipIdParseError:
+		return true
+	case PlatformErrorCodes_DestinyStatsParameterRangeParseError:
+		return true
+	case PlatformErrorCodes_DestinyStringItemHashNotFound:
+		return true
+	case PlatformErrorCodes_DestinyStringSetNotFound:
+		return true
+	case PlatformErrorCodes_DestinyContentLookupNotFoundForKey:
+		return true
+	case PlatformErrorCodes_DestinyContentItemNotFound:
+		return true
+	case PlatformErrorCodes_DestinyContentSectionNotFound:
+		return true
+	case PlatformErrorCodes_DestinyContentPropertyNotFound:
+		return true
+	case PlatformErrorCodes_DestinyContentConfigNotFound:
+		return true
+	case PlatformErrorCodes_DestinyContentPropertyBucketValueNotFound:
+		return true
+	case PlatformErrorCodes_DestinyUnexpectedError:
+		return true
+	case PlatformErrorCodes_DestinyInvalidAction:
+		return true
+	case PlatformErrorCodes_DestinyCharacterNotFound:
+		return true
+	case PlatformErrorCodes_DestinyInvalidFlag:
+		return true
+	case PlatformErrorCodes_DestinyInvalidRequest:
+		return true
+	case PlatformErrorCodes_DestinyItemNotFound:
+		return true
+	case PlatformErrorCodes_DestinyInvalidCustomizationChoices:
+		return true
+	case PlatformErrorCodes_DestinyVendorItemNotFound:
+		return true
+	case PlatformErrorCodes_DestinyInternalError:
+		return true
+	case PlatformErrorCodes_DestinyVendorNotFound:
+		return true
+	case PlatformErrorCodes_DestinyRecentActivitiesDatabaseError:
+		return true
+	case PlatformErrorCodes_DestinyItemBucketNotFound:
+		return true
+	case PlatformErrorCodes_DestinyInvalidMembershipType:
+		return true
+	case PlatformErrorCodes_DestinyVersionIncompatibility:
+		return true
+	case PlatformErrorCodes_DestinyItemAlreadyInInventory:
+		return true
+	case PlatformErrorCodes_DestinyBucketNotFound:
+		return true
+	case PlatformErrorCodes_DestinyCharacterNotInTower:
+		return true
+	case PlatformErrorCodes_DestinyCharacterNotLoggedIn:
+		return true
+	case PlatformErrorCodes_DestinyDefinitionsNotLoaded:
+		return true
+	case PlatformErrorCodes_DestinyInventoryFull:
+		return true
+	case PlatformErrorCodes_DestinyItemFailedLevelCheck:
+		return true
+	case PlatformErrorCodes_DestinyItemFailedUnlockCheck:
+		return true
+	case PlatformErrorCodes_DestinyItemUnequippable:
+		return true
+	case PlatformErrorCodes_DestinyItemUniqueEquipRestricted:
+		return true
+	case PlatformErrorCodes_DestinyNoRoomInDestination:
+		return true
+	case PlatformErrorCodes_DestinyServiceFailure:
+		return true
+	case PlatformErrorCodes_DestinyServiceRetired:
+		return true
+	case PlatformErrorCodes_DestinyTransferFailed:
+		return true
+	case PlatformErrorCodes_DestinyTransferNotFoundForSourceBucket:
+		return true
+	case PlatformErrorCodes_DestinyUnexpectedResultInVendorTransferCheck:
+		return true
+	case PlatformErrorCodes_DestinyUniquenessViolation:
+		return true
+	case PlatformErrorCodes_DestinyErrorDeserializationFailure:
+		return true
+	case PlatformErrorCodes_DestinyValidAccountTicketRequired:
+		return true
+	case PlatformErrorCodes_DestinyShardRelayClientTimeout:
+		return true
+	case PlatformErrorCodes_DestinyShardRelayProxyTimeout:
+		return true
+	case PlatformErrorCodes_DestinyPGCRNotFound:
+		return true
+	case PlatformErrorCodes_DestinyAccountMustBeOffline:
+		return true
+	case PlatformErrorCodes_DestinyCanOnlyEquipInGame:
+		return true
+	case PlatformErrorCodes_DestinyCannotPerformActionOnEquippedItem:
+		return true
+	case PlatformErrorCodes_DestinyQuestAlreadyCompleted:
+		return true
+	case PlatformErrorCodes_DestinyQuestAlreadyTracked:
+		return true
+	case PlatformErrorCodes_DestinyTrackableQuestsFull:
+		return true
+	case PlatformErrorCodes_DestinyItemNotTransferrable:
+		return true
+	case PlatformErrorCodes_DestinyVendorPurchaseNotAllowed:
+		return true
+	case PlatformErrorCodes_DestinyContentVersionMismatch:
+		return true
+	case PlatformErrorCodes_DestinyItemActionForbidden:
+		return true
+	case PlatformErrorCodes_DestinyRefundInvalid:
+		return true
+	case PlatformErrorCodes_DestinyPrivacyRestriction:
+		return true
+	case PlatformErrorCodes_DestinyActionInsufficientPrivileges:
+		return true
+	case PlatformErrorCodes_DestinyInvalidClaimException:
+		return true
+	case PlatformErrorCodes_DestinyLegacyPlatformRestricted:
+		return true
+	case PlatformErrorCodes_DestinyLegacyPlatformInUse:
+		return true
+	case PlatformErrorCodes_DestinyLegacyPlatformInaccessible:
+		return true
+	case PlatformErrorCodes_DestinyCannotPerformActionAtThisLocation:
+		return true
+	case PlatformErrorCodes_DestinyThrottledByGameServer:
+		return true
+	case PlatformErrorCodes_DestinyItemNotTransferrableHasSideEffects:
+		return true
+	case PlatformErrorCodes_DestinyItemLocked:
+		return true
+	case PlatformErrorCodes_DestinyCannotAffordMaterialRequirements:
+		return true
+	case PlatformErrorCodes_DestinyFailedPlugInsertionRules:
+		return true
+	case PlatformErrorCodes_DestinySocketNotFound:
+		return true
+	case PlatformErrorCodes_DestinySocketActionNotAllowed:
+		return true
+	case PlatformErrorCodes_DestinySocketAlreadyHasPlug:
+		return true
+	case PlatformErrorCodes_DestinyPlugItemNotAvailable:
+		return true
+	case PlatformErrorCodes_DestinyCharacterLoggedInNotAllowed:
+		return true
+	case PlatformErrorCodes_DestinyPublicAccountNotAccessible:
+		return true
+	case PlatformErrorCodes_DestinyClaimsItemAlreadyClaimed:
+		return true
+	case PlatformErrorCodes_DestinyClaimsNoInventorySpace:
+		return true
+	case PlatformErrorCodes_DestinyClaimsRequiredLevelNotMet:
+		return true
+	case PlatformErrorCodes_DestinyClaimsInvalidState:
+		return true
+	case PlatformErrorCodes_DestinyNotEnoughRoomForMultipleRewards:
+		return true
+	case PlatformErrorCodes_DestinyDirectBabelClientTimeout:
+		return true
+	case PlatformErrorCodes_FbInvalidRequest:
+		return true
+	case PlatformErrorCodes_FbRedirectMismatch:
+		return true
+	case PlatformErrorCodes_FbAccessDenied:
+		return true
+	case PlatformErrorCodes_FbUnsupportedResponseType:
+		return true
+	case PlatformErrorCodes_FbInvalidScope:
+		return true
+	case PlatformErrorCodes_FbUnsupportedGrantType:
+		return true
+	case PlatformErrorCodes_FbInvalidGrant:
+		return true
+	case PlatformErrorCodes_InvitationExpired:
+		return true
+	case PlatformErrorCodes_InvitationUnknownType:
+		return true
+	case PlatformErrorCodes_InvitationInvalidResponseStatus:
+		return true
+	case PlatformErrorCodes_InvitationInvalidType:
+		return true
+	case PlatformErrorCodes_InvitationAlreadyPending:
+		return true
+	case PlatformErrorCodes_InvitationInsufficientPermission:
+		return true
+	case PlatformErrorCodes_InvitationInvalidCode:
+		return true
+	case PlatformErrorCodes_InvitationInvalidTargetState:
+		return true
+	case PlatformErrorCodes_InvitationCannotBeReactivated:
+		return true
+	case PlatformErrorCodes_InvitationNoRecipients:
+		return true
+	case PlatformErrorCodes_InvitationGroupCannotSendToSelf:
+		return true
+	case PlatformErrorCodes_InvitationTooManyRecipients:
+		return true
+	case PlatformErrorCodes_InvitationInvalid:
+		return true
+	case PlatformErrorCodes_InvitationNotFound:
+		return true
+	case PlatformErrorCodes_TokenInvalid:
+		return true
+	case PlatformErrorCodes_TokenBadFormat:
+		return true
+	case PlatformErrorCodes_TokenAlreadyClaimed:
+		return true
+	case PlatformErrorCodes_TokenAlreadyClaimedSelf:
+		return true
+	case PlatformErrorCodes_TokenThrottling:
+		return true
+	case PlatformErrorCodes_TokenUnknownRedemptionFailure:
+		return true
+	case PlatformErrorCodes_TokenPurchaseClaimFailedAfterTokenClaimed:
+		return true
+	case PlatformErrorCodes_TokenUserAlreadyOwnsOffer:
+		return true
+	case PlatformErrorCodes_TokenInvalidOfferKey:
+		return true
+	case PlatformErrorCodes_TokenEmailNotValidated:
+		return true
+	case PlatformErrorCodes_TokenProvisioningBadVendorOrOffer:
+		return true
+	case PlatformErrorCodes_TokenPurchaseHistoryUnknownError:
+		return true
+	case PlatformErrorCodes_TokenThrottleStateUnknownError:
+		return true
+	case PlatformErrorCodes_TokenUserAgeNotVerified:
+		return true
+	case PlatformErrorCodes_TokenExceededOfferMaximum:
+		return true
+	case PlatformErrorCodes_TokenNoAvailableUnlocks:
+		return true
+	case PlatformErrorCodes_TokenMarketplaceInvalidPlatform:
+		return true
+	case PlatformErrorCodes_TokenNoMarketplaceCodesFound:
+		return true
+	case PlatformErrorCodes_TokenOfferNotAvailableForRedemption:
+		return true
+	case PlatformErrorCodes_TokenUnlockPartialFailure:
+		return true
+	case PlatformErrorCodes_TokenMarketplaceInvalidRegion:
+		return true
+	case PlatformErrorCodes_TokenOfferExpired:
+		return true
+	case PlatformErrorCodes_RAFExceededMaximumReferrals:
+		return true
+	case PlatformErrorCodes_RAFDuplicateBond:
+		return true
+	case PlatformErrorCodes_RAFNoValidVeteranDestinyMembershipsFound:
+		return true
+	case PlatformErrorCodes_RAFNotAValidVeteranUser:
+		return true
+	case PlatformErrorCodes_RAFCodeAlreadyClaimedOrNotFound:
+		return true
+	case PlatformErrorCodes_RAFMismatchedDestinyMembershipType:
+		return true
+	case PlatformErrorCodes_RAFUnableToAccessPurchaseHistory:
+		return true
+	case PlatformErrorCodes_RAFUnableToCreateBond:
+		return true
+	case PlatformErrorCodes_RAFUnableToFindBond:
+		return true
+	case PlatformErrorCodes_RAFUnableToRemoveBond:
+		return true
+	case PlatformErrorCodes_RAFCannotBondToSelf:
+		return true
+	case PlatformErrorCodes_RAFInvalidPlatform:
+		return true
+	case PlatformErrorCodes_RAFGenerateThrottled:
+		return true
+	case PlatformErrorCodes_RAFUnableToCreateBondVersionMismatch:
+		return true
+	case PlatformErrorCodes_RAFUnableToRemoveBondVersionMismatch:
+		return true
+	case PlatformErrorCodes_RAFRedeemThrottled:
+		return true
+	case PlatformErrorCodes_NoAvailableDiscountCode:
+		return true
+	case PlatformErrorCodes_DiscountAlreadyClaimed:
+		return true
+	case PlatformErrorCodes_DiscountClaimFailure:
+		return true
+	case PlatformErrorCodes_DiscountConfigurationFailure:
+		return true
+	case PlatformErrorCodes_DiscountGenerationFailure:
+		return true
+	case PlatformErrorCodes_DiscountAlreadyExists:
+		return true
+	case PlatformErrorCodes_TokenRequiresCredentialXuid:
+		return true
+	case PlatformErrorCodes_TokenRequiresCredentialPsnid:
+		return true
+	case PlatformErrorCodes_OfferRequired:
+		return true
+	case PlatformErrorCodes_UnknownEververseHistoryError:
+		return true
+	case PlatformErrorCodes_MissingEververseHistoryError:
+		return true
+	case PlatformErrorCodes_BungieRewardEmailStateInvalid:
+		return true
+	case PlatformErrorCodes_BungieRewardNotYetClaimable:
+		return true
+	case PlatformErrorCodes_MissingOfferConfig:
+		return true
+	case PlatformErrorCodes_RAFQuestEntitlementRequiresBnet:
+		return true
+	case PlatformErrorCodes_RAFQuestEntitlementTransportFailure:
+		return true
+	case PlatformErrorCodes_RAFQuestEntitlementUnknownFailure:
+		return true
+	case PlatformErrorCodes_RAFVeteranRewardUnknownFailure:
+		return true
+	case PlatformErrorCodes_RAFTooEarlyToCancelBond:
+		return true
+	case PlatformErrorCodes_LoyaltyRewardAlreadyRedeemed:
+		return true
+	case PlatformErrorCodes_UnclaimedLoyaltyRewardEntryNotFound:
+		return true
+	case PlatformErrorCodes_PartnerOfferPartialFailure:
+		return true
+	case PlatformErrorCodes_PartnerOfferAlreadyClaimed:
+		return true
+	case PlatformErrorCodes_PartnerOfferSkuNotFound:
+		return true
+	case PlatformErrorCodes_PartnerOfferSkuExpired:
+		return true
+	case PlatformErrorCodes_PartnerOfferPermissionFailure:
+		return true
+	case PlatformErrorCodes_PartnerOfferNoDestinyAccount:
+		return true
+	case PlatformErrorCodes_PartnerOfferApplyDataNotFound:
+		return true
+	case PlatformErrorCodes_ApiExceededMaxKeys:
+		return true
+	case PlatformErrorCodes_ApiInvalidOrExpiredKey:
+		return true
+	case PlatformErrorCodes_ApiKeyMissingFromRequest:
+		return true
+	case PlatformErrorCodes_ApplicationDisabled:
+		return true
+	case PlatformErrorCodes_ApplicationExceededMax:
+		return true
+	case PlatformErrorCodes_ApplicationDisallowedByScope:
+		return true
+	case PlatformErrorCodes_AuthorizationCodeInvalid:
+		return true
+	case PlatformErrorCodes_OriginHeaderDoesNotMatchKey:
+		return true
+	case PlatformErrorCodes_AccessNotPermittedByApplicationScope:
+		return true
+	case PlatformErrorCodes_ApplicationNameIsTaken:
+		return true
+	case PlatformErrorCodes_RefreshTokenNotYetValid:
+		return true
+	case PlatformErrorCodes_AccessTokenHasExpired:
+		return true
+	case PlatformErrorCodes_ApplicationTokenFormatNotValid:
+		return true
+	case PlatformErrorCodes_ApplicationNotConfiguredForBungieAuth:
+		return true
+	case PlatformErrorCodes_ApplicationNotConfiguredForOAuth:
+		return true
+	case PlatformErrorCodes_OAuthAccessTokenExpired:
+		return true
+	case PlatformErrorCodes_ApplicationTokenKeyIdDoesNotExist:
+		return true
+	case PlatformErrorCodes_ProvidedTokenNotValidRefreshToken:
+		return true
+	case PlatformErrorCodes_RefreshTokenExpired:
+		return true
+	case PlatformErrorCodes_AuthorizationRecordInvalid:
+		return true
+	case PlatformErrorCodes_TokenPreviouslyRevoked:
+		return true
+	case PlatformErrorCodes_TokenInvalidMembership:
+		return true
+	case PlatformErrorCodes_AuthorizationCodeStale:
+		return true
+	case PlatformErrorCodes_AuthorizationRecordExpired:
+		return true
+	case PlatformErrorCodes_AuthorizationRecordRevoked:
+		return true
+	case PlatformErrorCodes_AuthorizationRecordInactiveApiKey:
+		return true
+	case PlatformErrorCodes_AuthorizationRecordApiKeyMatching:
+		return true
+	case PlatformErrorCodes_PartnershipInvalidType:
+		return true
+	case PlatformErrorCodes_PartnershipValidationError:
+		return true
+	case PlatformErrorCodes_PartnershipValidationTimeout:
+		return true
+	case PlatformErrorCodes_PartnershipAccessFailure:
+		return true
+	case PlatformErrorCodes_PartnershipAccountInvalid:
+		return true
+	case PlatformErrorCodes_PartnershipGetAccountInfoFailure:
+		return true
+	case PlatformErrorCodes_PartnershipDisabled:
+		return true
+	case PlatformErrorCodes_PartnershipAlreadyExists:
+		return true
+	case PlatformErrorCodes_CommunityStreamingUnavailable:
+		return true
+	case PlatformErrorCodes_TwitchNotLinked:
+		return true
+	case PlatformErrorCodes_TwitchAccountNotFound:
+		return true
+	case PlatformErrorCodes_TwitchCouldNotLoadDestinyInfo:
+		return true
+	case PlatformErrorCodes_TwitchCouldNotRegisterUser:
+		return true
+	case PlatformErrorCodes_TwitchCouldNotUnregisterUser:
+		return true
+	case PlatformErrorCodes_TwitchRequiresRelinking:
+		return true
+	case PlatformErrorCodes_TwitchNoPlatformChosen:
+		return true
+	case PlatformErrorCodes_TwitchDropHistoryPermissionFailure:
+		return true
+	case PlatformErrorCodes_TwitchDropsRepairPartialFailure:
+		return true
+	case PlatformErrorCodes_TwitchNotAuthorized:
+		return true
+	case PlatformErrorCodes_TwitchUnknownAuthorizationFailure:
+		return true
+	case PlatformErrorCodes_TrendingCategoryNotFound:
+		return true
+	case PlatformErrorCodes_TrendingEntryTypeNotSupported:
+		return true
+	case PlatformErrorCodes_ReportOffenderNotInPgcr:
+		return true
+	case PlatformErrorCodes_ReportRequestorNotInPgcr:
+		return true
+	case PlatformErrorCodes_ReportSubmissionFailed:
+		return true
+	case PlatformErrorCodes_ReportCannotReportSelf:
+		return true
+	case PlatformErrorCodes_AwaTypeDisabled:
+		return true
+	case PlatformErrorCodes_AwaTooManyPendingRequests:
+		return true
+	case PlatformErrorCodes_AwaTheFeatureRequiresARegisteredDevice:
+		return true
+	case PlatformErrorCodes_AwaRequestWasUnansweredForTooLong:
+		return true
+	case PlatformErrorCodes_AwaWriteRequestMissingOrInvalidToken:
+		return true
+	case PlatformErrorCodes_AwaWriteRequestTokenExpired:
+		return true
+	case PlatformErrorCodes_AwaWriteRequestTokenUsageLimitReached:
+		return true
+	case PlatformErrorCodes_SteamWebApiError:
+		return true
+	case PlatformErrorCodes_SteamWebNullResponseError:
+		return true
+	case PlatformErrorCodes_SteamAccountRequired:
+		return true
+	case PlatformErrorCodes_SteamNotAuthorized:
+		return true
+	case PlatformErrorCodes_ClanFireteamNotFound:
+		return true
+	case PlatformErrorCodes_ClanFireteamAddNoAlternatesForImmediate:
+		return true
+	case PlatformErrorCodes_ClanFireteamFull:
+		return true
+	case PlatformErrorCodes_ClanFireteamAltFull:
+		return true
+	case PlatformErrorCodes_ClanFireteamBlocked:
+		return true
+	case PlatformErrorCodes_ClanFireteamPlayerEntryNotFound:
+		return true
+	case PlatformErrorCodes_ClanFireteamPermissions:
+		return true
+	case PlatformErrorCodes_ClanFireteamInvalidPlatform:
+		return true
+	case PlatformErrorCodes_ClanFireteamCannotAdjustSlotCount:
+		return true
+	case PlatformErrorCodes_ClanFireteamInvalidPlayerPlatform:
+		return true
+	case PlatformErrorCodes_ClanFireteamNotReadyForInvitesNotEnoughPlayers:
+		return true
+	case PlatformErrorCodes_ClanFireteamGameInvitesNotSupportForPlatform:
+		return true
+	case PlatformErrorCodes_ClanFireteamPlatformInvitePreqFailure:
+		return true
+	case PlatformErrorCodes_ClanFireteamInvalidAuthContext:
+		return true
+	case PlatformErrorCodes_ClanFireteamInvalidAuthProviderPsn:
+		return true
+	case PlatformErrorCodes_ClanFireteamPs4SessionFull:
+		return true
+	case PlatformErrorCodes_ClanFireteamInvalidAuthToken:
+		return true
+	case PlatformErrorCodes_ClanFireteamScheduledFireteamsDisabled:
+		return true
+	case PlatformErrorCodes_ClanFireteamNotReadyForInvitesNotScheduledYet:
+		return true
+	case PlatformErrorCodes_ClanFireteamNotReadyForInvitesClosed:
+		return true
+	case PlatformErrorCodes_ClanFireteamScheduledFireteamsRequireAdminPermissions:
+		return true
+	case PlatformErrorCodes_ClanFireteamNonPublicMustHaveClan:
+		return true
+	case PlatformErrorCodes_ClanFireteamPublicCreationRestriction:
+		return true
+	case PlatformErrorCodes_ClanFireteamAlreadyJoined:
+		return true
+	case PlatformErrorCodes_ClanFireteamScheduledFireteamsRange:
+		return true
+	case PlatformErrorCodes_ClanFireteamPublicCreationRestrictionExtended:
+		return true
+	case PlatformErrorCodes_ClanFireteamExpired:
+		return true
+	case PlatformErrorCodes_ClanFireteamInvalidAuthProvider:
+		return true
+	case PlatformErrorCodes_ClanFireteamInvalidAuthProviderXuid:
+		return true
+	case PlatformErrorCodes_ClanFireteamThrottle:
+		return true
+	case PlatformErrorCodes_ClanFireteamTooManyOpenScheduledFireteams:
+		return true
+	case PlatformErrorCodes_ClanFireteamCannotReopenScheduledFireteams:
+		return true
+	case PlatformErrorCodes_ClanFireteamJoinNoAccountSpecified:
+		return true
+	case PlatformErrorCodes_ClanFireteamMinDestiny2ProgressForCreation:
+		return true
+	case PlatformErrorCodes_ClanFireteamMinDestiny2ProgressForJoin:
+		return true
+	case PlatformErrorCodes_ClanFireteamSMSOrPurchaseRequiredCreate:
+		return true
+	case PlatformErrorCodes_ClanFireteamPurchaseRequiredCreate:
+		return true
+	case PlatformErrorCodes_ClanFireteamSMSOrPurchaseRequiredJoin:
+		return true
 	case PlatformErrorCodes_ClanFireteamPurchaseRequiredJoin:
-		return "ClanFireteamPurchaseRequiredJoin"
+		return true
 	case PlatformErrorCodes_FireteamFinderInvalidMembershipType:
-		return "FireteamFinderInvalidMembershipType"
+		return true
 	case PlatformErrorCodes_FireteamFinderInvalidMembershipId:
-		return "FireteamFinderInvalidMembershipId"
+		return true
 	case PlatformErrorCodes_FireteamFinderInvalidCharacterId:
-		return "FireteamFinderInvalidCharacterId"
+		return true
 	case PlatformErrorCodes_FireteamFinderInvalidListingOptions:
-		return "FireteamFinderInvalidListingOptions"
+		return true
 	case PlatformErrorCodes_FireteamFinderInvalidRequestData:
-		return "FireteamFinderInvalidRequestData"
+		return true
 	case PlatformErrorCodes_FireteamFinderListingApplicationFailed:
-		return "FireteamFinderListingApplicationFailed"
+		return true
 	case PlatformErrorCodes_FireteamFinderListingAutoJoinFailed:
-		return "FireteamFinderListingAutoJoinFailed"
+		return true
 	case PlatformErrorCodes_FireteamFinderPlayerApplicationsParsingFailed:
-		return "FireteamFinderPlayerApplicationsParsingFailed"
+		return true
 	case PlatformErrorCodes_FireteamFinderJoinLobbyHostFailed:
-		return "FireteamFinderJoinLobbyHostFailed"
+		return true
 	case PlatformErrorCodes_FireteamFinderPlayerNotInGame:
-		return "FireteamFinderPlayerNotInGame"
+		return true
 	case PlatformErrorCodes_FireteamFinderActivationFailed:
-		return "FireteamFinderActivationFailed"
+		return true
 	case PlatformErrorCodes_FireteamFinderApplicationNotFound:
-		return "FireteamFinderApplicationNotFound"
+		return true
 	case PlatformErrorCodes_FireteamFinderUserAlreadyAppliedToListing:
-		return "FireteamFinderUserAlreadyAppliedToListing"
+		return true
 	case PlatformErrorCodes_FireteamFinderApplicationClosedForUpdates:
-		return "FireteamFinderApplicationClosedForUpdates"
+		return true
 	case PlatformErrorCodes_FireteamFinderListingAtMaxOpenApplicationsLimit:
-		return "FireteamFinderListingAtMaxOpenApplicationsLimit"
+		return true
 	case PlatformErrorCodes_FireteamFinderUserNotInApplication:
-		return "FireteamFinderUserNotInApplication"
+		return true
 	case PlatformErrorCodes_FireteamFinderApplicationUserAlreadyListingOwner:
-		return "FireteamFinderApplicationUserAlreadyListingOwner"
+		return true
 	case PlatformErrorCodes_FireteamFinderOfferNotFound:
-		return "FireteamFinderOfferNotFound"
+		return true
 	case PlatformErrorCodes_FireteamFinderOfferClosedForUpdates:
-		return "FireteamFinderOfferClosedForUpdates"
+		return true
 	case PlatformErrorCodes_FireteamFinderOfferUserNotTarget:
-		return "FireteamFinderOfferUserNotTarget"
+		return true
 	case PlatformErrorCodes_FireteamFinderLobbyNotFound:
-		return "FireteamFinderLobbyNotFound"
+		return true
 	case PlatformErrorCodes_FireteamFinderListingNotFound:
-		return "FireteamFinderListingNotFound"
+		return true
 	case PlatformErrorCodes_FireteamFinderLobbyFull:
-		return "FireteamFinderLobbyFull"
+		return true
 	case PlatformErrorCodes_FireteamFinderUserNotListingOwner:
-		return "FireteamFinderUserNotListingOwner"
+		return true
 	case PlatformErrorCodes_FireteamFinderUserNotLobbyOwner:
-		return "FireteamFinderUserNotLobbyOwner"
+		return true
 	case PlatformErrorCodes_FireteamFinderLobbyClosedForUpdates:
-		return "FireteamFinderLobbyClosedForUpdates"
+		return true
 	case PlatformErrorCodes_FireteamFinderUserNotInLobby:
-		return "FireteamFinderUserNotInLobby"
+		return true
 	case PlatformErrorCodes_FireteamFinderDisabledSettingsValue:
-		return "FireteamFinderDisabledSettingsValue"
+		return true
 	case PlatformErrorCodes_FireteamFinderOwnerInActiveLobby:
-		return "FireteamFinderOwnerInActiveLobby"
+		return true
 	case PlatformErrorCodes_FireteamFinderApplicationClosedToOfflinePlayers:
-		return "FireteamFinderApplicationClosedToOfflinePlayers"
+		return true
 	case PlatformErrorCodes_FireteamFinderUserNotApplicationOwner:
-		return "FireteamFinderUserNotApplicationOwner"
+		return true
 	case PlatformErrorCodes_FireteamFinderInviteValidationFailed:
-		return "FireteamFinderInviteValidationFailed"
+		return true
 	case PlatformErrorCodes_FireteamFinderOwnerNotInGame:
-		return "FireteamFinderOwnerNotInGame"
+		return true
 	case PlatformErrorCodes_FireteamFinderPlayerAtMaxLobbyLimit:
-		return "FireteamFinderPlayerAtMaxLobbyLimit"
+		return true
 	case PlatformErrorCodes_FireteamFinderLobbyTooFarInTheFuture:
-		return "FireteamFinderLobbyTooFarInTheFuture"
+		return true
 	case PlatformErrorCodes_FireteamFinderApplicantNotInGame:
-		return "FireteamFinderApplicantNotInGame"
+		return true
 	case PlatformErrorCodes_FireteamFinderResponseUndefined:
-		return "FireteamFinderResponseUndefined"
+		return true
 	case PlatformErrorCodes_FireteamFinderResponseMoved:
-		return "FireteamFinderResponseMoved"
+		return true
 	case PlatformErrorCodes_FireteamFinderResponseLoggingIn:
-		return "FireteamFinderResponseLoggingIn"
+		return true
 	case PlatformErrorCodes_FireteamFinderResponseBadRequest:
-		return "FireteamFinderResponseBadRequest"
+		return true
 	case PlatformErrorCodes_FireteamFinderResponseUnauthorized:
-		return "FireteamFinderResponseUnauthorized"
+		return true
 	case PlatformErrorCodes_FireteamFinderResponseForbidden:
-		return "FireteamFinderResponseForbidden"
+		return true
 	case PlatformErrorCodes_FireteamFinderResponseNotFound:
-		return "FireteamFinderResponseNotFound"
+		return true
 	case PlatformErrorCodes_FireteamFinderInternalServerError:
-		return "FireteamFinderInternalServerError"
+		return true
 	case PlatformErrorCodes_FireteamFinderServiceUnavailable:
-		return "FireteamFinderServiceUnavailable"
+		return true
 	case PlatformErrorCodes_FireteamFinderInternalServerErrorNonFatal:
-		return "FireteamFinderInternalServerErrorNonFatal"
+		return true
 	case PlatformErrorCodes_CrossSaveOverriddenAccountNotFound:
-		return "CrossSaveOverriddenAccountNotFound"
+		return true
 	case PlatformErrorCodes_CrossSaveTooManyOverriddenPlatforms:
-		return "CrossSaveTooManyOverriddenPlatforms"
+		return true
 	case PlatformErrorCodes_CrossSaveNoOverriddenPlatforms:
-		return "CrossSaveNoOverriddenPlatforms"
+		return true
 	case PlatformErrorCodes_CrossSavePrimaryAccountNotFound:
-		return "CrossSavePrimaryAccountNotFound"
+		return true
 	case PlatformErrorCodes_CrossSaveRequestInvalid:
-		return "CrossSaveRequestInvalid"
+		return true
 	case PlatformErrorCodes_CrossSaveBungieAccountValidationFailure:
-		return "CrossSaveBungieAccountValidationFailure"
+		return true
 	case PlatformErrorCodes_CrossSaveOverriddenPlatformNotAllowed:
-		return "CrossSaveOverriddenPlatformNotAllowed"
+		return true
 	case PlatformErrorCodes_CrossSaveThresholdExceeded:
-		return "CrossSaveThresholdExceeded"
+		return true
 	case PlatformErrorCodes_CrossSaveIncompatibleMembershipType:
-		return "CrossSaveIncompatibleMembershipType"
+		return true
 	case PlatformErrorCodes_CrossSaveCouldNotFindLinkedAccountForMembershipType:
-		return "CrossSaveCouldNotFindLinkedAccountForMembershipType"
+		return true
 	case PlatformErrorCodes_CrossSaveCouldNotCreateDestinyProfileForMembershipType:
-		return "CrossSaveCouldNotCreateDestinyProfileForMembershipType"
+		return true
 	case PlatformErrorCodes_CrossSaveErrorCreatingDestinyProfileForMembershipType:
-		return "CrossSaveErrorCreatingDestinyProfileForMembershipType"
+		return true
 	case PlatformErrorCodes_CrossSaveCannotOverrideSelf:
-		return "CrossSaveCannotOverrideSelf"
+		return true
 	case PlatformErrorCodes_CrossSaveRecentSilverPurchase:
-		return "CrossSaveRecentSilverPurchase"
+		return true
 	case PlatformErrorCodes_CrossSaveSilverBalanceNegative:
-		return "CrossSaveSilverBalanceNegative"
+		return true
 	case PlatformErrorCodes_CrossSaveAccountNotAuthenticated:
-		return "CrossSaveAccountNotAuthenticated"
+		return true
 	case PlatformErrorCodes_ErrorOneAccountAlreadyActive:
-		return "ErrorOneAccountAlreadyActive"
+		return true
 	case PlatformErrorCodes_ErrorOneAccountDestinyRestriction:
-		return "ErrorOneAccountDestinyRestriction"
+		return true
 	case PlatformErrorCodes_CrossSaveMustMigrateToSteam:
-		return "CrossSaveMustMigrateToSteam"
+		return true
 	case PlatformErrorCodes_CrossSaveSteamAlreadyPaired:
-		return "CrossSaveSteamAlreadyPaired"
+		return true
 	case PlatformErrorCodes_CrossSaveCannotPairJustSteamAndBlizzard:
-		return "CrossSaveCannotPairJustSteamAndBlizzard"
+		return true
 	case PlatformErrorCodes_CrossSaveCannotPairSteamAloneBeforeShadowkeep:
-		return "CrossSaveCannotPairSteamAloneBeforeShadowkeep"
+		return true
 	case PlatformErrorCodes_AuthVerificationNotLinkedToAccount:
-		return "AuthVerificationNotLinkedToAccount"
+		return true
 	case PlatformErrorCodes_PCMigrationMissingBlizzard:
-		return "PCMigrationMissingBlizzard"
+		return true
 	case PlatformErrorCodes_PCMigrationMissingSteam:
-		return "PCMigrationMissingSteam"
+		return true
 	case PlatformErrorCodes_PCMigrationInvalidBlizzard:
-		return "PCMigrationInvalidBlizzard"
+		return true
 	case PlatformErrorCodes_PCMigrationInvalidSteam:
-		return "PCMigrationInvalidSteam"
+		return true
 	case PlatformErrorCodes_PCMigrationUnknownFailure:
-		return "PCMigrationUnknownFailure"
+		return true
 	case PlatformErrorCodes_PCMigrationUnknownException:
-		return "PCMigrationUnknownException"
+		return true
 	case PlatformErrorCodes_PCMigrationNotLinked:
-		return "PCMigrationNotLinked"
+		return true
 	case PlatformErrorCodes_PCMigrationAccountsAlreadyUsed:
-		return "PCMigrationAccountsAlreadyUsed"
+		return true
 	case PlatformErrorCodes_PCMigrationStepFailed:
-		return "PCMigrationStepFailed"
+		return true
 	case PlatformErrorCodes_PCMigrationInvalidBlizzardCrossSaveState:
-		return "PCMigrationInvalidBlizzardCrossSaveState"
+		return true
 	case PlatformErrorCodes_PCMigrationDestinationBanned:
-		return "PCMigrationDestinationBanned"
+		return true
 	case PlatformErrorCodes_PCMigrationDestinyFailure:
-		return "PCMigrationDestinyFailure"
+		return true
 	case PlatformErrorCodes_PCMigrationSilverTransferFailed:
-		return "PCMigrationSilverTransferFailed"
+		return true
 	case PlatformErrorCodes_PCMigrationEntitlementTransferFailed:
-		return "PCMigrationEntitlementTransferFailed"
+		return true
 	case PlatformErrorCodes_PCMigrationCannotStompClanFounder:
-		return "PCMigrationCannotStompClanFounder"
+		return true
 	case PlatformErrorCodes_UnsupportedBrowser:
-		return "UnsupportedBrowser"
+		return true
 	case PlatformErrorCodes_StadiaAccountRequired:
-		return "StadiaAccountRequired"
+		return true
 	case PlatformErrorCodes_ErrorPhoneValidationTooManyUses:
-		return "ErrorPhoneValidationTooManyUses"
+		return true
 	case PlatformErrorCodes_ErrorPhoneValidationNoAssociatedPhone:
-		return "ErrorPhoneValidationNoAssociatedPhone"
+		return true
 	case PlatformErrorCodes_ErrorPhoneValidationCodeInvalid:
-		return "ErrorPhoneValidationCodeInvalid"
+		return true
 	case PlatformErrorCodes_ErrorPhoneValidationBanned:
-		return "ErrorPhoneValidationBanned"
+		return true
 	case PlatformErrorCodes_ErrorPhoneValidationCodeTooRecentlySent:
-		return "ErrorPhoneValidationCodeTooRecentlySent"
+		return true
 	case PlatformErrorCodes_ErrorPhoneValidationCodeExpired:
-		return "ErrorPhoneValidationCodeExpired"
+		return true
 	case PlatformErrorCodes_ErrorPhoneValidationInvalidNumberType:
-		return "ErrorPhoneValidationInvalidNumberType"
+		return true
 	case PlatformErrorCodes_ErrorPhoneValidationCodeTooRecentlyChecked:
-		return "ErrorPhoneValidationCodeTooRecentlyChecked"
+		return true
 	case PlatformErrorCodes_ErrorPhoneValidationRecentlyPlayedDestiny2AccountRequired:
-		return "ErrorPhoneValidationRecentlyPlayedDestiny2AccountRequired"
+		return true
 	case PlatformErrorCodes_ApplePushErrorUnknown:
-		return "ApplePushErrorUnknown"
+		return true
 	case PlatformErrorCodes_ApplePushErrorNull:
-		return "ApplePushErrorNull"
+		return true
 	case PlatformErrorCodes_ApplePushErrorTimeout:
-		return "ApplePushErrorTimeout"
+		return true
 	case PlatformErrorCodes_ApplePushBadRequest:
-		return "ApplePushBadRequest"
+		return true
 	case PlatformErrorCodes_ApplePushFailedAuth:
-		return "ApplePushFailedAuth"
+		return true
 	case PlatformErrorCodes_ApplePushThrottled:
-		return "ApplePushThrottled"
+		return true
 	case PlatformErrorCodes_ApplePushServiceUnavailable:
-		return "ApplePushServiceUnavailable"
+		return true
 	case PlatformErrorCodes_NotAnImageOrVideo:
-		return "NotAnImageOrVideo"
+		return true
 	case PlatformErrorCodes_ErrorBungieFriendsBlockFailed:
-		return "ErrorBungieFriendsBlockFailed"
+		return true
 	case PlatformErrorCodes_ErrorBungieFriendsAutoReject:
-		return "ErrorBungieFriendsAutoReject"
+		return true
 	case PlatformErrorCodes_ErrorBungieFriendsNoRequestFound:
-		return "ErrorBungieFriendsNoRequestFound"
+		return true
 	case PlatformErrorCodes_ErrorBungieFriendsAlreadyFriends:
-		return "ErrorBungieFriendsAlreadyFriends"
+		return true
 	case PlatformErrorCodes_ErrorBungieFriendsUnableToRemoveRequest:
-		return "ErrorBungieFriendsUnableToRemoveRequest"
+		return true
 	case PlatformErrorCodes_ErrorBungieFriendsUnableToRemove:
-		return "ErrorBungieFriendsUnableToRemove"
+		return true
 	case PlatformErrorCodes_ErrorBungieFriendsIdenticalSourceTarget:
-		return "ErrorBungieFriendsIdenticalSourceTarget"
+		return true
 	case PlatformErrorCodes_ErrorBungieFriendsSelf:
-		return "ErrorBungieFriendsSelf"
+		return true
 	case PlatformErrorCodes_ErrorBungieBlockSelf:
-		return "ErrorBungieBlockSelf"
+		return true
 	case PlatformErrorCodes_ErrorBungieFriendsListFull:
-		return "ErrorBungieFriendsListFull"
+		return true
 	case PlatformErrorCodes_ErrorBungieBlockListFull:
-		return "ErrorBungieBlockListFull"
+		return true
 	case PlatformErrorCodes_ErrorBungieFriendNotFound:
-		return "ErrorBungieFriendNotFound"
+		return true
 	case PlatformErrorCodes_ErrorBungieFriendInvalidMembershipType:
-		return "ErrorBungieFriendInvalidMembershipType"
+		return true
 	case PlatformErrorCodes_ErrorEgsUnknown:
-		return "ErrorEgsUnknown"
+		return true
 	case PlatformErrorCodes_ErrorEgsBadRequest:
-		return "ErrorEgsBadRequest"
+		return true
 	case PlatformErrorCodes_ErrorEgsNotAuthorized:
-		return "ErrorEgsNotAuthorized"
+		return true
 	case PlatformErrorCodes_ErrorEgsForbidden:
-		return "ErrorEgsForbidden"
+		return true
 	case PlatformErrorCodes_ErrorEgsAccountNotFound:
-		return "ErrorEgsAccountNotFound"
+		return true
 	case PlatformErrorCodes_ErrorEgsWebException:
-		return "ErrorEgsWebException"
+		return true
 	case PlatformErrorCodes_ErrorEgsUnavailable:
-		return "ErrorEgsUnavailable"
+		return true
 	case PlatformErrorCodes_ErrorEgsJwksMissing:
-		return "ErrorEgsJwksMissing"
+		return true
 	case PlatformErrorCodes_ErrorEgsJwtMalformedHeader:
-		return "ErrorEgsJwtMalformedHeader"
+		return true
 	case PlatformErrorCodes_ErrorEgsJwtMalformedPayload:
-		return "ErrorEgsJwtMalformedPayload"
+		return true
 	}
-	return fmt.Sprintf("PlatformErrorCodes_%d", e)
+	return false
 }
 
 func (e FireteamDateRange) Enum() string {
@@ -53753,6 +61070,23 @@ func (e FireteamDateRange) Enum() string {
 	return fmt.Sprintf("FireteamDateRange_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e FireteamDateRange) Valid() bool {
+	switch e {
+	case FireteamDateRange_All:
+		return true
+	case FireteamDateRange_Now:
+		return true
+	case FireteamDateRange_TwentyFourHours:
+		return true
+	case FireteamDateRange_FortyEightHours:
+		return true
+	case FireteamDateRange_ThisWeek:
+		return true
+	}
+	return false
+}
+
 func (e FireteamPlatform) Enum() string {
 	switch e {
 	case FireteamPlatform_Any:
@@ -53773,6 +61107,27 @@ func (e FireteamPlatform) Enum() string {
 	return fmt.Sprintf("FireteamPlatform_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e FireteamPlatform) Valid() bool {
+	switch e {
+	case FireteamPlatform_Any:
+		return true
+	case FireteamPlatform_Playstation4:
+		return true
+	case FireteamPlatform_XboxOne:
+		return true
+	case FireteamPlatform_Blizzard:
+		return true
+	case FireteamPlatform_Steam:
+		return true
+	case FireteamPlatform_Stadia:
+		return true
+	case FireteamPlatform_Egs:
+		return true
+	}
+	return false
+}
+
 func (e FireteamPlatformInviteResult) Enum() string {
 	switch e {
 	case FireteamPlatformInviteResult_None:
@@ -53789,6 +61144,23 @@ func (e FireteamPlatformInviteResult) Enum() string {
 	return fmt.Sprintf("FireteamPlatformInviteResult_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e FireteamPlatformInviteResult) Valid() bool {
+	switch e {
+	case FireteamPlatformInviteResult_None:
+		return true
+	case FireteamPlatformInviteResult_Success:
+		return true
+	case FireteamPlatformInviteResult_AlreadyInFireteam:
+		return true
+	case FireteamPlatformInviteResult_Throttled:
+		return true
+	case FireteamPlatformInviteResult_ServiceError:
+		return true
+	}
+	return false
+}
+
 func (e FireteamPublicSearchOption) Enum() string {
 	switch e {
 	case FireteamPublicSearchOption_PublicAndPrivate:
@@ -53801,6 +61173,19 @@ func (e FireteamPublicSearchOption) Enum() string {
 	return fmt.Sprintf("FireteamPublicSearchOption_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e FireteamPublicSearchOption) Valid() bool {
+	switch e {
+	case FireteamPublicSearchOption_PublicAndPrivate:
+		return true
+	case FireteamPublicSearchOption_PublicOnly:
+		return true
+	case FireteamPublicSearchOption_PrivateOnly:
+		return true
+	}
+	return false
+}
+
 func (e FireteamSlotSearch) Enum() string {
 	switch e {
 	case FireteamSlotSearch_NoSlotRestriction:
@@ -53813,6 +61198,19 @@ func (e FireteamSlotSearch) Enum() string {
 	return fmt.Sprintf("FireteamSlotSearch_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e FireteamSlotSearch) Valid() bool {
+	switch e {
+	case FireteamSlotSearch_NoSlotRestriction:
+		return true
+	case FireteamSlotSearch_HasOpenPlayerSlots:
+		return true
+	case FireteamSlotSearch_HasOpenPlayerOrAltSlots:
+		return true
+	}
+	return false
+}
+
 func (e CommunityContentSortMode) Enum() string {
 	switch e {
 	case CommunityContentSortMode_Trending:
@@ -53825,6 +61223,19 @@ func (e CommunityContentSortMode) Enum() string {
 	return fmt.Sprintf("CommunityContentSortMode_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e CommunityContentSortMode) Valid() bool {
+	switch e {
+	case CommunityContentSortMode_Trending:
+		return true
+	case CommunityContentSortMode_Latest:
+		return true
+	case CommunityContentSortMode_HighestRated:
+		return true
+	}
+	return false
+}
+
 func (e ForumMediaType) Enum() string {
 	switch e {
 	case ForumMediaType_None:
@@ -53839,6 +61250,21 @@ func (e ForumMediaType) Enum() string {
 	return fmt.Sprintf("ForumMediaType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ForumMediaType) Valid() bool {
+	switch e {
+	case ForumMediaType_None:
+		return true
+	case ForumMediaType_Image:
+		return true
+	case ForumMediaType_Video:
+		return true
+	case ForumMediaType_Youtube:
+		return true
+	}
+	return false
+}
+
 func (e ForumPostPopularity) Enum() string {
 	switch e {
 	case ForumPostPopularity_Empty:
@@ -53857,6 +61283,25 @@ func (e ForumPostPopularity) Enum() string {
 	return fmt.Sprintf("ForumPostPopularity_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ForumPostPopularity) Valid() bool {
+	switch e {
+	case ForumPostPopularity_Empty:
+		return true
+	case ForumPostPopularity_Default:
+		return true
+	case ForumPostPopularity_Discussed:
+		return true
+	case ForumPostPopularity_CoolStory:
+		return true
+	case ForumPostPopularity_HeatingUp:
+		return true
+	case ForumPostPopularity_Hot:
+		return true
+	}
+	return false
+}
+
 func (e ForumPostSort) Enum() string {
 	switch e {
 	case ForumPostSort_Default:
@@ -53867,6 +61312,17 @@ func (e ForumPostSort) Enum() string {
 	return fmt.Sprintf("ForumPostSort_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ForumPostSort) Valid() bool {
+	switch e {
+	case ForumPostSort_Default:
+		return true
+	case ForumPostSort_OldestFirst:
+		return true
+	}
+	return false
+}
+
 func (e ForumRecruitmentIntensityLabel) Enum() string {
 	switch e {
 	case ForumRecruitmentIntensityLabel_None:
@@ -53879,6 +61335,19 @@ func (e ForumRecruitmentIntensityLabel) Enum() string {
 	return fmt.Sprintf("ForumRecruitmentIntensityLabel_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ForumRecruitmentIntensityLabel) Valid() bool {
+	switch e {
+	case ForumRecruitmentIntensityLabel_None:
+		return true
+	case ForumRecruitmentIntensityLabel_Casual:
+		return true
+	case ForumRecruitmentIntensityLabel_Professional:
+		return true
+	}
+	return false
+}
+
 func (e ForumRecruitmentToneLabel) Enum() string {
 	switch e {
 	case ForumRecruitmentToneLabel_None:
@@ -53891,6 +61360,19 @@ func (e ForumRecruitmentToneLabel) Enum() string {
 	return fmt.Sprintf("ForumRecruitmentToneLabel_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ForumRecruitmentToneLabel) Valid() bool {
+	switch e {
+	case ForumRecruitmentToneLabel_None:
+		return true
+	case ForumRecruitmentToneLabel_FamilyFriendly:
+		return true
+	case ForumRecruitmentToneLabel_Rowdy:
+		return true
+	}
+	return false
+}
+
 func (e ForumTopicsCategoryFilters) Enum() string {
 	switch e {
 	case ForumTopicsCategoryFilters_None:
@@ -53915,6 +61397,31 @@ func (e ForumTopicsCategoryFilters) Enum() string {
 	return fmt.Sprintf("ForumTopicsCategoryFilters_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ForumTopicsCategoryFilters) Valid() bool {
+	switch e {
+	case ForumTopicsCategoryFilters_None:
+		return true
+	case ForumTopicsCategoryFilters_Links:
+		return true
+	case ForumTopicsCategoryFilters_Questions:
+		return true
+	case ForumTopicsCategoryFilters_AnsweredQuestions:
+		return true
+	case ForumTopicsCategoryFilters_Media:
+		return true
+	case ForumTopicsCategoryFilters_TextOnly:
+		return true
+	case ForumTopicsCategoryFilters_Announcement:
+		return true
+	case ForumTopicsCategoryFilters_BungieOfficial:
+		return true
+	case ForumTopicsCategoryFilters_Polls:
+		return true
+	}
+	return false
+}
+
 func (e ForumTopicsQuickDate) Enum() string {
 	switch e {
 	case ForumTopicsQuickDate_All:
@@ -53931,6 +61438,23 @@ func (e ForumTopicsQuickDate) Enum() string {
 	return fmt.Sprintf("ForumTopicsQuickDate_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ForumTopicsQuickDate) Valid() bool {
+	switch e {
+	case ForumTopicsQuickDate_All:
+		return true
+	case ForumTopicsQuickDate_LastYear:
+		return true
+	case ForumTopicsQuickDate_LastMonth:
+		return true
+	case ForumTopicsQuickDate_LastWeek:
+		return true
+	case ForumTopicsQuickDate_LastDay:
+		return true
+	}
+	return false
+}
+
 func (e ForumTopicsSort) Enum() string {
 	switch e {
 	case ForumTopicsSort_Default:
@@ -53953,6 +61477,29 @@ func (e ForumTopicsSort) Enum() string {
 	return fmt.Sprintf("ForumTopicsSort_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ForumTopicsSort) Valid() bool {
+	switch e {
+	case ForumTopicsSort_Default:
+		return true
+	case ForumTopicsSort_LastReplied:
+		return true
+	case ForumTopicsSort_MostReplied:
+		return true
+	case ForumTopicsSort_Popularity:
+		return true
+	case ForumTopicsSort_Controversiality:
+		return true
+	case ForumTopicsSort_Liked:
+		return true
+	case ForumTopicsSort_HighestRated:
+		return true
+	case ForumTopicsSort_MostUpvoted:
+		return true
+	}
+	return false
+}
+
 func (e ForumFlags) Enum() string {
 	switch e {
 	case ForumFlags_None:
@@ -53977,6 +61524,31 @@ func (e ForumFlags) Enum() string {
 	return fmt.Sprintf("ForumFlags_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ForumFlags) Valid() bool {
+	switch e {
+	case ForumFlags_None:
+		return true
+	case ForumFlags_BungieStaffPost:
+		return true
+	case ForumFlags_ForumNinjaPost:
+		return true
+	case ForumFlags_ForumMentorPost:
+		return true
+	case ForumFlags_TopicBungieStaffPosted:
+		return true
+	case ForumFlags_TopicBungieVolunteerPosted:
+		return true
+	case ForumFlags_QuestionAnsweredByBungie:
+		return true
+	case ForumFlags_QuestionAnsweredByNinja:
+		return true
+	case ForumFlags_CommunityContent:
+		return true
+	}
+	return false
+}
+
 func (e ForumPostCategory) Enum() string {
 	switch e {
 	case ForumPostCategory_None:
@@ -54007,6 +61579,37 @@ func (e ForumPostCategory) Enum() string {
 	return fmt.Sprintf("ForumPostCategory_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ForumPostCategory) Valid() bool {
+	switch e {
+	case ForumPostCategory_None:
+		return true
+	case ForumPostCategory_TextOnly:
+		return true
+	case ForumPostCategory_Media:
+		return true
+	case ForumPostCategory_Link:
+		return true
+	case ForumPostCategory_Poll:
+		return true
+	case ForumPostCategory_Question:
+		return true
+	case ForumPostCategory_Answered:
+		return true
+	case ForumPostCategory_Announcement:
+		return true
+	case ForumPostCategory_ContentComment:
+		return true
+	case ForumPostCategory_BungieOfficial:
+		return true
+	case ForumPostCategory_NinjaOfficial:
+		return true
+	case ForumPostCategory_Recruitment:
+		return true
+	}
+	return false
+}
+
 func (e GlobalAlertLevel) Enum() string {
 	switch e {
 	case GlobalAlertLevel_Unknown:
@@ -54021,6 +61624,21 @@ func (e GlobalAlertLevel) Enum() string {
 	return fmt.Sprintf("GlobalAlertLevel_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e GlobalAlertLevel) Valid() bool {
+	switch e {
+	case GlobalAlertLevel_Unknown:
+		return true
+	case GlobalAlertLevel_Blue:
+		return true
+	case GlobalAlertLevel_Yellow:
+		return true
+	case GlobalAlertLevel_Red:
+		return true
+	}
+	return false
+}
+
 func (e GlobalAlertType) Enum() string {
 	switch e {
 	case GlobalAlertType_GlobalAlert:
@@ -54031,6 +61649,17 @@ func (e GlobalAlertType) Enum() string {
 	return fmt.Sprintf("GlobalAlertType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e GlobalAlertType) Valid() bool {
+	switch e {
+	case GlobalAlertType_GlobalAlert:
+		return true
+	case GlobalAlertType_StreamingAlert:
+		return true
+	}
+	return false
+}
+
 func (e Capabilities) Enum() string {
 	switch e {
 	case Capabilities_None:
@@ -54053,6 +61682,29 @@ func (e Capabilities) Enum() string {
 	return fmt.Sprintf("Capabilities_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e Capabilities) Valid() bool {
+	switch e {
+	case Capabilities_None:
+		return true
+	case Capabilities_Leaderboards:
+		return true
+	case Capabilities_Callsign:
+		return true
+	case Capabilities_OptionalConversations:
+		return true
+	case Capabilities_ClanBanner:
+		return true
+	case Capabilities_D2InvestmentData:
+		return true
+	case Capabilities_Tags:
+		return true
+	case Capabilities_Alliances:
+		return true
+	}
+	return false
+}
+
 func (e ChatSecuritySetting) Enum() string {
 	switch e {
 	case ChatSecuritySetting_Group:
@@ -54063,6 +61715,17 @@ func (e ChatSecuritySetting) Enum() string {
 	return fmt.Sprintf("ChatSecuritySetting_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e ChatSecuritySetting) Valid() bool {
+	switch e {
+	case ChatSecuritySetting_Group:
+		return true
+	case ChatSecuritySetting_Admins:
+		return true
+	}
+	return false
+}
+
 func (e GroupAllianceStatus) Enum() string {
 	switch e {
 	case GroupAllianceStatus_Unallied:
@@ -54075,6 +61738,19 @@ func (e GroupAllianceStatus) Enum() string {
 	return fmt.Sprintf("GroupAllianceStatus_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e GroupAllianceStatus) Valid() bool {
+	switch e {
+	case GroupAllianceStatus_Unallied:
+		return true
+	case GroupAllianceStatus_Parent:
+		return true
+	case GroupAllianceStatus_Child:
+		return true
+	}
+	return false
+}
+
 func (e GroupApplicationResolveState) Enum() string {
 	switch e {
 	case GroupApplicationResolveState_Unresolved:
@@ -54089,6 +61765,21 @@ func (e GroupApplicationResolveState) Enum() string {
 	return fmt.Sprintf("GroupApplicationResolveState_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e GroupApplicationResolveState) Valid() bool {
+	switch e {
+	case GroupApplicationResolveState_Unresolved:
+		return true
+	case GroupApplicationResolveState_Accepted:
+		return true
+	case GroupApplicationResolveState_Denied:
+		return true
+	case GroupApplicationResolveState_Rescinded:
+		return true
+	}
+	return false
+}
+
 func (e GroupDateRange) Enum() string {
 	switch e {
 	case GroupDateRange_All:
@@ -54105,6 +61796,23 @@ func (e GroupDateRange) Enum() string {
 	return fmt.Sprintf("GroupDateRange_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e GroupDateRange) Valid() bool {
+	switch e {
+	case GroupDateRange_All:
+		return true
+	case GroupDateRange_PastDay:
+		return true
+	case GroupDateRange_PastWeek:
+		return true
+	case GroupDateRange_PastMonth:
+		return true
+	case GroupDateRange_PastYear:
+		return true
+	}
+	return false
+}
+
 func (e GroupHomepage) Enum() string {
 	switch e {
 	case GroupHomepage_Wall:
@@ -54117,6 +61825,19 @@ func (e GroupHomepage) Enum() string {
 	return fmt.Sprintf("GroupHomepage_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e GroupHomepage) Valid() bool {
+	switch e {
+	case GroupHomepage_Wall:
+		return true
+	case GroupHomepage_Forum:
+		return true
+	case GroupHomepage_AllianceForum:
+		return true
+	}
+	return false
+}
+
 func (e GroupMemberCountFilter) Enum() string {
 	switch e {
 	case GroupMemberCountFilter_All:
@@ -54131,6 +61852,21 @@ func (e GroupMemberCountFilter) Enum() string {
 	return fmt.Sprintf("GroupMemberCountFilter_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e GroupMemberCountFilter) Valid() bool {
+	switch e {
+	case GroupMemberCountFilter_All:
+		return true
+	case GroupMemberCountFilter_OneToTen:
+		return true
+	case GroupMemberCountFilter_ElevenToOneHundred:
+		return true
+	case GroupMemberCountFilter_GreaterThanOneHundred:
+		return true
+	}
+	return false
+}
+
 func (e GroupPostPublicity) Enum() string {
 	switch e {
 	case GroupPostPublicity_Public:
@@ -54143,6 +61879,19 @@ func (e GroupPostPublicity) Enum() string {
 	return fmt.Sprintf("GroupPostPublicity_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e GroupPostPublicity) Valid() bool {
+	switch e {
+	case GroupPostPublicity_Public:
+		return true
+	case GroupPostPublicity_Alliance:
+		return true
+	case GroupPostPublicity_Private:
+		return true
+	}
+	return false
+}
+
 func (e GroupPotentialMemberStatus) Enum() string {
 	switch e {
 	case GroupPotentialMemberStatus_None:
@@ -54155,6 +61904,19 @@ func (e GroupPotentialMemberStatus) Enum() string {
 	return fmt.Sprintf("GroupPotentialMemberStatus_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e GroupPotentialMemberStatus) Valid() bool {
+	switch e {
+	case GroupPotentialMemberStatus_None:
+		return true
+	case GroupPotentialMemberStatus_Applicant:
+		return true
+	case GroupPotentialMemberStatus_Invitee:
+		return true
+	}
+	return false
+}
+
 func (e GroupSortBy) Enum() string {
 	switch e {
 	case GroupSortBy_Name:
@@ -54169,6 +61931,21 @@ func (e GroupSortBy) Enum() string {
 	return fmt.Sprintf("GroupSortBy_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e GroupSortBy) Valid() bool {
+	switch e {
+	case GroupSortBy_Name:
+		return true
+	case GroupSortBy_Date:
+		return true
+	case GroupSortBy_Popularity:
+		return true
+	case GroupSortBy_Id:
+		return true
+	}
+	return false
+}
+
 func (e GroupType) Enum() string {
 	switch e {
 	case GroupType_General:
@@ -54179,6 +61956,17 @@ func (e GroupType) Enum() string {
 	return fmt.Sprintf("GroupType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e GroupType) Valid() bool {
+	switch e {
+	case GroupType_General:
+		return true
+	case GroupType_Clan:
+		return true
+	}
+	return false
+}
+
 func (e GroupsForMemberFilter) Enum() string {
 	switch e {
 	case GroupsForMemberFilter_All:
@@ -54191,6 +61979,19 @@ func (e GroupsForMemberFilter) Enum() string {
 	return fmt.Sprintf("GroupsForMemberFilter_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e GroupsForMemberFilter) Valid() bool {
+	switch e {
+	case GroupsForMemberFilter_All:
+		return true
+	case GroupsForMemberFilter_Founded:
+		return true
+	case GroupsForMemberFilter_NonFounded:
+		return true
+	}
+	return false
+}
+
 func (e HostGuidedGamesPermissionLevel) Enum() string {
 	switch e {
 	case HostGuidedGamesPermissionLevel_None:
@@ -54203,6 +62004,19 @@ func (e HostGuidedGamesPermissionLevel) Enum() string {
 	return fmt.Sprintf("HostGuidedGamesPermissionLevel_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e HostGuidedGamesPermissionLevel) Valid() bool {
+	switch e {
+	case HostGuidedGamesPermissionLevel_None:
+		return true
+	case HostGuidedGamesPermissionLevel_Beginner:
+		return true
+	case HostGuidedGamesPermissionLevel_Member:
+		return true
+	}
+	return false
+}
+
 func (e MembershipOption) Enum() string {
 	switch e {
 	case MembershipOption_Reviewed:
@@ -54215,6 +62029,19 @@ func (e MembershipOption) Enum() string {
 	return fmt.Sprintf("MembershipOption_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e MembershipOption) Valid() bool {
+	switch e {
+	case MembershipOption_Reviewed:
+		return true
+	case MembershipOption_Open:
+		return true
+	case MembershipOption_Closed:
+		return true
+	}
+	return false
+}
+
 func (e RuntimeGroupMemberType) Enum() string {
 	switch e {
 	case RuntimeGroupMemberType_None:
@@ -54233,6 +62060,25 @@ func (e RuntimeGroupMemberType) Enum() string {
 	return fmt.Sprintf("RuntimeGroupMemberType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e RuntimeGroupMemberType) Valid() bool {
+	switch e {
+	case RuntimeGroupMemberType_None:
+		return true
+	case RuntimeGroupMemberType_Beginner:
+		return true
+	case RuntimeGroupMemberType_Member:
+		return true
+	case RuntimeGroupMemberType_Admin:
+		return true
+	case RuntimeGroupMemberType_ActingFounder:
+		return true
+	case RuntimeGroupMemberType_Founder:
+		return true
+	}
+	return false
+}
+
 func (e IgnoreLength) Enum() string {
 	switch e {
 	case IgnoreLength_None:
@@ -54263,6 +62109,37 @@ func (e IgnoreLength) Enum() string {
 	return fmt.Sprintf("IgnoreLength_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e IgnoreLength) Valid() bool {
+	switch e {
+	case IgnoreLength_None:
+		return true
+	case IgnoreLength_Week:
+		return true
+	case IgnoreLength_TwoWeeks:
+		return true
+	case IgnoreLength_ThreeWeeks:
+		return true
+	case IgnoreLength_Month:
+		return true
+	case IgnoreLength_ThreeMonths:
+		return true
+	case IgnoreLength_SixMonths:
+		return true
+	case IgnoreLength_Year:
+		return true
+	case IgnoreLength_Forever:
+		return true
+	case IgnoreLength_ThreeMinutes:
+		return true
+	case IgnoreLength_Hour:
+		return true
+	case IgnoreLength_ThirtyDays:
+		return true
+	}
+	return false
+}
+
 func (e IgnoreStatus) Enum() string {
 	switch e {
 	case IgnoreStatus_NotIgnored:
@@ -54283,6 +62160,27 @@ func (e IgnoreStatus) Enum() string {
 	return fmt.Sprintf("IgnoreStatus_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e IgnoreStatus) Valid() bool {
+	switch e {
+	case IgnoreStatus_NotIgnored:
+		return true
+	case IgnoreStatus_IgnoredUser:
+		return true
+	case IgnoreStatus_IgnoredGroup:
+		return true
+	case IgnoreStatus_IgnoredByGroup:
+		return true
+	case IgnoreStatus_IgnoredPost:
+		return true
+	case IgnoreStatus_IgnoredTag:
+		return true
+	case IgnoreStatus_IgnoredGlobal:
+		return true
+	}
+	return false
+}
+
 func (e FriendRelationshipState) Enum() string {
 	switch e {
 	case FriendRelationshipState_Unknown:
@@ -54297,6 +62195,21 @@ func (e FriendRelationshipState) Enum() string {
 	return fmt.Sprintf("FriendRelationshipState_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e FriendRelationshipState) Valid() bool {
+	switch e {
+	case FriendRelationshipState_Unknown:
+		return true
+	case FriendRelationshipState_Friend:
+		return true
+	case FriendRelationshipState_IncomingRequest:
+		return true
+	case FriendRelationshipState_OutgoingRequest:
+		return true
+	}
+	return false
+}
+
 func (e PlatformFriendType) Enum() string {
 	switch e {
 	case PlatformFriendType_Unknown:
@@ -54313,6 +62226,23 @@ func (e PlatformFriendType) Enum() string {
 	return fmt.Sprintf("PlatformFriendType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e PlatformFriendType) Valid() bool {
+	switch e {
+	case PlatformFriendType_Unknown:
+		return true
+	case PlatformFriendType_Xbox:
+		return true
+	case PlatformFriendType_PSN:
+		return true
+	case PlatformFriendType_Steam:
+		return true
+	case PlatformFriendType_Egs:
+		return true
+	}
+	return false
+}
+
 func (e PresenceOnlineStateFlags) Enum() string {
 	switch e {
 	case PresenceOnlineStateFlags_None:
@@ -54325,6 +62255,19 @@ func (e PresenceOnlineStateFlags) Enum() string {
 	return fmt.Sprintf("PresenceOnlineStateFlags_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e PresenceOnlineStateFlags) Valid() bool {
+	switch e {
+	case PresenceOnlineStateFlags_None:
+		return true
+	case PresenceOnlineStateFlags_Destiny1:
+		return true
+	case PresenceOnlineStateFlags_Destiny2:
+		return true
+	}
+	return false
+}
+
 func (e PresenceStatus) Enum() string {
 	switch e {
 	case PresenceStatus_OfflineOrUnknown:
@@ -54335,6 +62278,17 @@ func (e PresenceStatus) Enum() string {
 	return fmt.Sprintf("PresenceStatus_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e PresenceStatus) Valid() bool {
+	switch e {
+	case PresenceStatus_OfflineOrUnknown:
+		return true
+	case PresenceStatus_Online:
+		return true
+	}
+	return false
+}
+
 func (e DropState) Enum() string {
 	switch e {
 	case DropState_Claimed:
@@ -54347,6 +62301,19 @@ func (e DropState) Enum() string {
 	return fmt.Sprintf("DropState_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e DropState) Valid() bool {
+	switch e {
+	case DropState_Claimed:
+		return true
+	case DropState_Applied:
+		return true
+	case DropState_Fulfilled:
+		return true
+	}
+	return false
+}
+
 func (e TrendingEntryType) Enum() string {
 	switch e {
 	case TrendingEntryType_News:
@@ -54377,6 +62344,37 @@ func (e TrendingEntryType) Enum() string {
 	return fmt.Sprintf("TrendingEntryType_%d", e)
 }
 
+// Valid reports whether e is one of the values defined by the API.
+func (e TrendingEntryType) Valid() bool {
+	switch e {
+	case TrendingEntryType_News:
+		return true
+	case TrendingEntryType_DestinyItem:
+		return true
+	case TrendingEntryType_DestinyActivity:
+		return true
+	case TrendingEntryType_DestinyRitual:
+		return true
+	case TrendingEntryType_SupportArticle:
+		return true
+	case TrendingEntryType_Creation:
+		return true
+	case TrendingEntryType_Stream:
+		return true
+	case TrendingEntryType_Update:
+		return true
+	case TrendingEntryType_Link:
+		return true
+	case TrendingEntryType_ForumTag:
+		return true
+	case TrendingEntryType_Container:
+		return true
+	case TrendingEntryType_Release:
+		return true
+	}
+	return false
+}
+
 func (e OptInFlags) Enum() string {
 	switch e {
 	case OptInFlags_None:
@@ -54402,3 +62400,216 @@ func (e OptInFlags) Enum() string {
 	}
 	return fmt.Sprintf("OptInFlags_%d", e)
 }
+
+// Valid reports whether e is one of the values defined by the API.
+func (e OptInFlags) Valid() bool {
+	switch e {
+	case OptInFlags_None:
+		return true
+	case OptInFlags_Newsletter:
+		return true
+	case OptInFlags_System:
+		return true
+	case OptInFlags_Marketing:
+		return true
+	case OptInFlags_UserResearch:
+		return true
+	case OptInFlags_CustomerService:
+		return true
+	case OptInFlags_Social:
+		return true
+	case OptInFlags_PlayTests:
+		return true
+	case OptInFlags_PlayTestsLocal:
+		return true
+	case OptInFlags_Careers:
+		return true
+	}
+	return false
+}
+
+// ComponentDependencies returns the ComponentType that must be requested to populate each field of
+// BaseItemComponentSet.
+func (BaseItemComponentSet[T]) ComponentDependencies() map[string]ComponentType {
+	return map[string]ComponentType{
+		"Objectives": ComponentType_ItemObjectives,
+		"Perks":      ComponentType_ItemPerks,
+	}
+}
+
+// ComponentErrors returns ErrComponentPrivate or ErrComponentDisabled for each component of
+// BaseItemComponentSet that was requested but not returned, keyed by field path.
+func (r BaseItemComponentSet[T]) ComponentErrors() map[string]error {
+	return componentErrors(r)
+}
+
+// ComponentDependencies returns the ComponentType that must be requested to populate each field of
+// ItemComponentSet.
+func (ItemComponentSet[T]) ComponentDependencies() map[string]ComponentType {
+	return map[string]ComponentType{
+		"Instances":      ComponentType_ItemInstances,
+		"Objectives":     ComponentType_ItemObjectives,
+		"Perks":          ComponentType_ItemPerks,
+		"PlugObjectives": ComponentType_ItemPlugObjectives,
+		"PlugStates":     ComponentType_ItemPlugStates,
+		"RenderData":     ComponentType_ItemRenderData,
+		"ReusablePlugs":  ComponentType_ItemReusablePlugs,
+		"Sockets":        ComponentType_ItemSockets,
+		"Stats":          ComponentType_ItemStats,
+		"TalentGrids":    ComponentType_ItemTalentGrids,
+	}
+}
+
+// ComponentErrors returns ErrComponentPrivate or ErrComponentDisabled for each component of
+// ItemComponentSet that was requested but not returned, keyed by field path.
+func (r ItemComponentSet[T]) ComponentErrors() map[string]error {
+	return componentErrors(r)
+}
+
+// OperationThrottles maps ClientRequest.Operation to the minimum time between calls by the same user,
+// as documented by ThrottleSecondsBetweenActionPerUser in the spec.
+var OperationThrottles = map[string]time.Duration{
+	"Destiny2.UpdateLoadoutIdentifiers": 1 * time.Second,
+	"Destiny2.SnapshotLoadout":          1 * time.Second,
+	"Destiny2.EquipLoadout":             1 * time.Second,
+	"Destiny2.ClearLoadout":             1 * time.Second,
+	"Destiny2.TransferItem":             100 * time.Millisecond,
+	"Destiny2.SetQuestTrackedState":     1 * time.Second,
+	"Destiny2.SetItemLockState":         100 * time.Millisecond,
+	"Destiny2.PullFromPostmaster":       100 * time.Millisecond,
+	"Destiny2.InsertSocketPlugFree":     500 * time.Millisecond,
+	"Destiny2.InsertSocketPlug":         500 * time.Millisecond,
+	"Destiny2.EquipItems":               100 * time.Millisecond,
+	"Destiny2.EquipItem":                100 * time.Millisecond,
+}
+
+// OperationComponents maps ClientRequest.Operation to the components that the operation can return,
+// for operations that take a components parameter.
+var OperationComponents = map[string][]ComponentType{
+	"Destiny2.GetPublicVendors": {
+		ComponentType_Vendors,
+		ComponentType_VendorCategories,
+		ComponentType_VendorSales,
+		ComponentType_StringVariables,
+	},
+	"Destiny2.GetProfile": {
+		ComponentType_Profiles,
+		ComponentType_VendorReceipts,
+		ComponentType_ProfileInventories,
+		ComponentType_ProfileCurrencies,
+		ComponentType_ProfileProgression,
+		ComponentType_PlatformSilver,
+		ComponentType_Characters,
+		ComponentType_CharacterInventories,
+		ComponentType_CharacterProgressions,
+		ComponentType_CharacterRenderData,
+		ComponentType_CharacterActivities,
+		ComponentType_CharacterEquipment,
+		ComponentType_CharacterLoadouts,
+		ComponentType_ItemInstances,
+		ComponentType_ItemObjectives,
+		ComponentType_ItemPerks,
+		ComponentType_ItemRenderData,
+		ComponentType_ItemStats,
+		ComponentType_ItemSockets,
+		ComponentType_ItemTalentGrids,
+		ComponentType_ItemPlugStates,
+		ComponentType_ItemPlugObjectives,
+		ComponentType_ItemReusablePlugs,
+		ComponentType_Kiosks,
+		ComponentType_CurrencyLookups,
+		ComponentType_PresentationNodes,
+		ComponentType_Collectibles,
+		ComponentType_Records,
+		ComponentType_Transitory,
+		ComponentType_Metrics,
+		ComponentType_StringVariables,
+		ComponentType_Craftables,
+		ComponentType_SocialCommendations,
+	},
+	"Destiny2.GetItem": {
+		ComponentType_ItemInstances,
+		ComponentType_ItemObjectives,
+		ComponentType_ItemPerks,
+		ComponentType_ItemRenderData,
+		ComponentType_ItemStats,
+		ComponentType_ItemSockets,
+		ComponentType_ItemTalentGrids,
+		ComponentType_ItemCommonData,
+		ComponentType_ItemPlugObjectives,
+		ComponentType_ItemReusablePlugs,
+	},
+	"Destiny2.GetVendors": {
+		ComponentType_ItemInstances,
+		ComponentType_ItemObjectives,
+		ComponentType_ItemPerks,
+		ComponentType_ItemRenderData,
+		ComponentType_ItemStats,
+		ComponentType_ItemSockets,
+		ComponentType_ItemTalentGrids,
+		ComponentType_ItemCommonData,
+		ComponentType_ItemPlugStates,
+		ComponentType_ItemPlugObjectives,
+		ComponentType_ItemReusablePlugs,
+		ComponentType_Vendors,
+		ComponentType_VendorCategories,
+		ComponentType_VendorSales,
+		ComponentType_CurrencyLookups,
+		ComponentType_StringVariables,
+	},
+	"Destiny2.GetCharacter": {
+		ComponentType_Characters,
+		ComponentType_CharacterInventories,
+		ComponentType_CharacterProgressions,
+		ComponentType_CharacterRenderData,
+		ComponentType_CharacterActivities,
+		ComponentType_CharacterEquipment,
+		ComponentType_CharacterLoadouts,
+		ComponentType_ItemInstances,
+		ComponentType_ItemObjectives,
+		ComponentType_ItemPerks,
+		ComponentType_ItemRenderData,
+		ComponentType_ItemStats,
+		ComponentType_ItemSockets,
+		ComponentType_ItemTalentGrids,
+		ComponentType_ItemPlugStates,
+		ComponentType_ItemPlugObjectives,
+		ComponentType_ItemReusablePlugs,
+		ComponentType_Kiosks,
+		ComponentType_CurrencyLookups,
+		ComponentType_PresentationNodes,
+		ComponentType_Collectibles,
+		ComponentType_Records,
+	},
+	"Destiny2.GetVendor": {
+		ComponentType_ItemInstances,
+		ComponentType_ItemObjectives,
+		ComponentType_ItemPerks,
+		ComponentType_ItemRenderData,
+		ComponentType_ItemStats,
+		ComponentType_ItemSockets,
+		ComponentType_ItemTalentGrids,
+		ComponentType_ItemCommonData,
+		ComponentType_ItemPlugStates,
+		ComponentType_ItemPlugObjectives,
+		ComponentType_ItemReusablePlugs,
+		ComponentType_Vendors,
+		ComponentType_VendorCategories,
+		ComponentType_VendorSales,
+		ComponentType_CurrencyLookups,
+		ComponentType_StringVariables,
+	},
+	"Destiny2.GetCollectibleNodeDetails": {
+		ComponentType_ItemInstances,
+		ComponentType_ItemObjectives,
+		ComponentType_ItemPerks,
+		ComponentType_ItemRenderData,
+		ComponentType_ItemStats,
+		ComponentType_ItemSockets,
+		ComponentType_ItemTalentGrids,
+		ComponentType_ItemPlugStates,
+		ComponentType_ItemPlugObjectives,
+		ComponentType_ItemReusablePlugs,
+		ComponentType_Collectibles,
+	},
+}
@@ -0,0 +1,47 @@
+package bnet
+
+import "context"
+
+// Page is one page of results from a paged operation.
+type Page[T any] struct {
+	Results []T
+	HasMore bool
+
+	// ContinuationToken is passed to the request for the next page, if the operation supports it.
+	ContinuationToken string
+}
+
+// Paginate returns an iterator over the results of every page, starting at page start. fetch is
+// called with the page number and the continuation token from the previous page. Iteration stops
+// after a page without HasMore, an empty page, or the first error, which is yielded with the zero T.
+//
+// Pages are fetched one at a time as the loop consumes them, so an iterator never has more than
+// one request in flight, and breaking out of the loop fetches no further pages.
+//
+//	for member, err := range bnet.Paginate(ctx, 1, fetch) {
+//		...
+//	}
+//
+// The generated XxxAll methods, such as API.GroupV2GetMembersOfGroupAll, use Paginate.
+func Paginate[T any](ctx context.Context, start int32, fetch func(ctx context.Context, page int32, token string) (Page[T], error)) func(yield func(T, error) bool) {
+	return func(yield func(T, error) bool) {
+		var token string
+		for page := start; ; page++ {
+			p, err := fetch(ctx, page, token)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, v := range p.Results {
+				if !yield(v, nil) {
+					return
+				}
+			}
+			if !p.HasMore || len(p.Results) == 0 {
+				return
+			}
+			token = p.ContinuationToken
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package bnet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func TestPaginate(t *testing.T) {
+	var pages []string
+	api := (&API{}).WithInterceptorFunc(func(_ Client, ctx context.Context, r ClientRequest, resp any) error {
+		page := r.PathParams["currentpage"]
+		pages = append(pages, page)
+		return json.Unmarshal([]byte(fmt.Sprintf(`{"ErrorCode":1,"Response":{
+			"results":[{"groupId":"%s1"},{"groupId":"%s2"}],"hasMore":%t}}`, page, page, page != "3")), resp)
+	})
+
+	var ids []string
+	api.GroupV2GetMembersOfGroupAll(context.Background(), GroupV2GetMembersOfGroupRequest{GroupID: 1, Currentpage: 1})(func(m GroupMember, err error) bool {
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, fmt.Sprint(m.GroupID))
+		return true
+	})
+	if got, want := fmt.Sprint(ids), "[11 12 21 22 31 32]"; got != want {
+		t.Fatalf("ids = %s; want %s", got, want)
+	}
+
+	pages = nil
+	api.GroupV2GetMembersOfGroupAll(context.Background(), GroupV2GetMembersOfGroupRequest{GroupID: 1, Currentpage: 1})(func(m GroupMember, err error) bool {
+		return m.GroupID != 21
+	})
+	if got := fmt.Sprint(pages); got != "[1 2]" {
+		t.Fatalf("pages = %s; want [1 2]", got)
+	}
+}
@@ -0,0 +1,145 @@
+package bnet
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimit configures the behavior of API.WithRateLimiter.
+type RateLimit struct {
+	// PerUser maps ClientRequest.Operation to the minimum time between calls by the same user.
+	// Users are told apart by their Authorization header. If nil, OperationThrottles is used.
+	PerUser map[string]time.Duration
+
+	// Global is the number of requests per second allowed for the API key across all users.
+	// Zero means no global limit.
+	Global float64
+
+	// Burst is the number of requests that may exceed Global at once. Zero means 1.
+	Burst int
+
+	// OnWait, if set, is called before a call is delayed.
+	OnWait func(operation string, d time.Duration)
+}
+
+// WithRateLimiter delays calls so that they stay within the limits of l.
+//
+// The limiter state is shared by every API derived from the returned one, so install it before
+// per-user interceptors such as WithAuthToken:
+//
+//	base := bnet.NewAPI(key).WithRateLimiter(bnet.RateLimit{Global: 20})
+//	userAPI := base.WithAuthToken(tok)
+func (a *API) WithRateLimiter(l RateLimit) *API {
+	rl := newRateLimiter(l)
+	return a.WithInterceptorFunc(func(base Client, ctx context.Context, r ClientRequest, resp any) error {
+		if err := rl.wait(ctx, r, l.OnWait); err != nil {
+			return err
+		}
+		return base.Do(ctx, r, resp)
+	})
+}
+
+type rateKey struct {
+	user      string
+	operation string
+}
+
+type rateLimiter struct {
+	perUser map[string]time.Duration
+
+	mu     sync.Mutex
+	bucket *tokenBucket
+	next   map[rateKey]time.Time
+}
+
+func newRateLimiter(l RateLimit) *rateLimiter {
+	rl := &rateLimiter{perUser: l.PerUser, next: make(map[rateKey]time.Time)}
+	if rl.perUser == nil {
+		rl.perUser = OperationThrottles
+	}
+	if l.Global > 0 {
+		burst := l.Burst
+		if burst <= 0 {
+			burst = 1
+		}
+		rl.bucket = &tokenBucket{rate: l.Global, burst: float64(burst), tokens: float64(burst)}
+	}
+	return rl
+}
+
+func (rl *rateLimiter) wait(ctx context.Context, r ClientRequest, onWait func(string, time.Duration)) error {
+	d := rl.reserve(time.Now(), r)
+	if d <= 0 {
+		return nil
+	}
+	if onWait != nil {
+		onWait(r.Operation, d)
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// reserve claims a slot for r and returns how long the caller must wait before using it.
+func (rl *rateLimiter) reserve(now time.Time, r ClientRequest) time.Duration {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	var wait time.Duration
+	if rl.bucket != nil {
+		wait = rl.bucket.reserve(now)
+	}
+	if spacing := rl.perUser[r.Operation]; spacing > 0 {
+		key := rateKey{user: r.Headers["Authorization"], operation: r.Operation}
+		at := now.Add(wait)
+		if next := rl.next[key]; next.After(at) {
+			at = next
+		}
+		rl.next[key] = at.Add(spacing)
+		wait = at.Sub(now)
+		rl.sweep(now)
+	}
+	return wait
+}
+
+// sweep forgets users whose spacing has already elapsed, so the map doesn't grow without bound.
+func (rl *rateLimiter) sweep(now time.Time) {
+	if len(rl.next) < 1024 {
+		return
+	}
+	for k, next := range rl.next {
+		if next.Before(now) {
+			delete(rl.next, k)
+		}
+	}
+}
+
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// reserve takes a token and returns how long until it is available.
+// Tokens can go negative, which queues later callers behind earlier ones.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	if !b.last.IsZero() {
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+	}
+	b.last = now
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
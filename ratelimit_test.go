@@ -0,0 +1,39 @@
+package bnet
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRateLimiterPerUser(t *testing.T) {
+	rl := newRateLimiter(RateLimit{PerUser: map[string]time.Duration{"Destiny2.EquipItem": time.Second}})
+	now := time.Now()
+	alice := ClientRequest{Operation: "Destiny2.EquipItem", Headers: map[string]string{"Authorization": "Bearer a"}}
+	bob := ClientRequest{Operation: "Destiny2.EquipItem", Headers: map[string]string{"Authorization": "Bearer b"}}
+	other := ClientRequest{Operation: "Destiny2.GetProfile", Headers: alice.Headers}
+
+	for _, tc := range []struct {
+		r    ClientRequest
+		want time.Duration
+	}{
+		{alice, 0},
+		{alice, time.Second},
+		{bob, 0},
+		{other, 0},
+		{alice, 2 * time.Second},
+	} {
+		if got := rl.reserve(now, tc.r); got != tc.want {
+			t.Errorf("reserve(%v) = %v; want %v", tc.r.Headers, got, tc.want)
+		}
+	}
+}
+
+func TestRateLimiterGlobal(t *testing.T) {
+	rl := newRateLimiter(RateLimit{Global: 10, Burst: 2})
+	now := time.Now()
+	for i, want := range []time.Duration{0, 0, 100 * time.Millisecond, 200 * time.Millisecond} {
+		if got := rl.reserve(now, ClientRequest{}); got != want {
+			t.Errorf("reserve #%d = %v; want %v", i, got, want)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package bnet
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net"
+	"net/http"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures the behavior of API.WithRetry.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts per call, including the first. Zero means 5.
+	MaxAttempts int
+
+	// BaseDelay is the backoff before the first retry. It doubles on every attempt. Zero means 1s.
+	BaseDelay time.Duration
+
+	// MaxDelay caps a single backoff. Zero means 1m.
+	MaxDelay time.Duration
+
+	// MaxElapsed bounds the total time spent on a call, in addition to the context deadline.
+	// Zero means no bound other than the context.
+	MaxElapsed time.Duration
+
+	// Retryable reports whether a call that failed with err should be retried.
+	// If nil, IsRetryable is used.
+	Retryable func(err error) bool
+
+	// OnRetry, if set, is called before waiting to retry a call that failed with err.
+	OnRetry func(operation string, err error, wait time.Duration)
+}
+
+// DefaultRetryPolicy is a reasonable policy for batch jobs.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 5,
+	BaseDelay:   time.Second,
+	MaxDelay:    time.Minute,
+}
+
+// retryableCodes are the PlatformErrorCodes that indicate a transient failure.
+var retryableCodes = map[PlatformErrorCodes]bool{
+	PlatformErrorCodes_UnhandledException:                          true,
+	PlatformErrorCodes_SystemDisabled:                              true,
+	PlatformErrorCodes_ExternalServiceTimeout:                      true,
+	PlatformErrorCodes_ThrottleLimitExceeded:                       true,
+	PlatformErrorCodes_ThrottleLimitExceededMinutes:                true,
+	PlatformErrorCodes_ThrottleLimitExceededMomentarily:            true,
+	PlatformErrorCodes_ThrottleLimitExceededSeconds:                true,
+	PlatformErrorCodes_PerEndpointRequestThrottleExceeded:          true,
+	PlatformErrorCodes_PerApplicationThrottleExceeded:              true,
+	PlatformErrorCodes_PerApplicationAnonymousThrottleExceeded:     true,
+	PlatformErrorCodes_PerApplicationAuthenticatedThrottleExceeded: true,
+	PlatformErrorCodes_PerUserThrottleExceeded:                     true,
+	PlatformErrorCodes_DestinyShardRelayClientTimeout:              true,
+	PlatformErrorCodes_DestinyShardRelayProxyTimeout:               true,
+	PlatformErrorCodes_DestinyThrottledByGameServer:                true,
+	PlatformErrorCodes_DestinyDirectBabelClientTimeout:             true,
+}
+
+// IsRetryable reports whether err is a transient error: a throttling or outage PlatformErrorCodes,
+// an HTTP 429 or 5xx, or a network error.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	var bErr *BungieError
+	if errors.As(err, &bErr) {
+		return retryableCodes[bErr.Code]
+	}
+	var hErr *HTTPError
+	if errors.As(err, &hErr) {
+		return hErr.Code == http.StatusTooManyRequests || hErr.Code >= 500
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var nErr net.Error
+	return errors.As(err, &nErr)
+}
+
+// WithRetry retries calls that fail with a transient error, using jittered exponential backoff.
+// Waits requested by the server through ThrottleSeconds or Retry-After are honored.
+// A call gives up early if the next wait would not finish before the context deadline.
+func (a *API) WithRetry(p RetryPolicy) *API {
+	return a.WithInterceptorFunc(func(base Client, ctx context.Context, r ClientRequest, resp any) error {
+		return p.do(base, ctx, r, resp)
+	})
+}
+
+func (p RetryPolicy) do(base Client, ctx context.Context, r ClientRequest, resp any) error {
+	maxAttempts := p.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultRetryPolicy.MaxAttempts
+	}
+	retryable := p.Retryable
+	if retryable == nil {
+		retryable = IsRetryable
+	}
+	var deadline time.Time
+	if p.MaxElapsed > 0 {
+		deadline = time.Now().Add(p.MaxElapsed)
+	}
+	if d, ok := ctx.Deadline(); ok && (deadline.IsZero() || d.Before(deadline)) {
+		deadline = d
+	}
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			resetResponse(resp)
+		}
+		err := base.Do(ctx, r, resp)
+		if err == nil || attempt+1 >= maxAttempts || !retryable(err) {
+			return err
+		}
+		wait := p.backoff(attempt)
+		if hint := serverWait(err); hint > wait {
+			wait = hint
+		}
+		if !deadline.IsZero() && time.Now().Add(wait).After(deadline) {
+			return err
+		}
+		if p.OnRetry != nil {
+			p.OnRetry(r.Operation, err, wait)
+		}
+		t := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return err
+		case <-t.C:
+		}
+	}
+}
+
+// backoff returns the jittered delay before retry number attempt+1.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	base := p.BaseDelay
+	if base <= 0 {
+		base = DefaultRetryPolicy.BaseDelay
+	}
+	max := p.MaxDelay
+	if max <= 0 {
+		max = DefaultRetryPolicy.MaxDelay
+	}
+	d := base
+	for i := 0; i < attempt && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+	// Equal jitter: somewhere in [d/2, d].
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// serverWait returns the wait requested by the server in err, if any.
+func serverWait(err error) time.Duration {
+	var bErr *BungieError
+	if errors.As(err, &bErr) && bErr.ThrottleSeconds > 0 {
+		return time.Duration(bErr.ThrottleSeconds) * time.Second
+	}
+	var hErr *HTTPError
+	if errors.As(err, &hErr) && hErr.Header != nil {
+		return parseRetryAfter(hErr.Header.Get("Retry-After"))
+	}
+	return 0
+}
+
+func parseRetryAfter(v string) time.Duration {
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return time.Until(t)
+	}
+	return 0
+}
+
+// resetResponse zeroes resp so that a retry doesn't see data from a previous attempt.
+func resetResponse(resp any) {
+	v := reflect.ValueOf(resp)
+	if v.Kind() == reflect.Pointer && !v.IsNil() {
+		v.Elem().SetZero()
+	}
+}
@@ -0,0 +1,65 @@
+package bnet
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type fakeClient struct {
+	errs  []error
+	calls int
+}
+
+func (c *fakeClient) Do(ctx context.Context, r ClientRequest, resp any) error {
+	c.calls++
+	if len(c.errs) == 0 {
+		return nil
+	}
+	err := c.errs[0]
+	c.errs = c.errs[1:]
+	return err
+}
+
+func TestRetry(t *testing.T) {
+	fc := &fakeClient{errs: []error{
+		&BungieError{Code: PlatformErrorCodes_ThrottleLimitExceeded},
+		&HTTPError{Code: 503},
+	}}
+	p := RetryPolicy{BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+	var resp ServerResponse[int32]
+	if err := p.do(fc, context.Background(), ClientRequest{}, &resp); err != nil {
+		t.Fatal(err)
+	}
+	if fc.calls != 3 {
+		t.Fatalf("calls = %d; want 3", fc.calls)
+	}
+}
+
+func TestRetryNotRetryable(t *testing.T) {
+	want := &BungieError{Code: PlatformErrorCodes_DestinyAccountNotFound}
+	fc := &fakeClient{errs: []error{want}}
+	p := RetryPolicy{BaseDelay: time.Millisecond}
+	if err := p.do(fc, context.Background(), ClientRequest{}, nil); !errors.Is(err, want) {
+		t.Fatalf("err = %v; want %v", err, want)
+	}
+	if fc.calls != 1 {
+		t.Fatalf("calls = %d; want 1", fc.calls)
+	}
+}
+
+func TestRetryDeadline(t *testing.T) {
+	fc := &fakeClient{errs: []error{
+		&BungieError{Code: PlatformErrorCodes_ThrottleLimitExceeded, ThrottleSeconds: 60},
+	}}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	p := RetryPolicy{BaseDelay: time.Millisecond}
+	if err := p.do(fc, ctx, ClientRequest{}, nil); err == nil {
+		t.Fatal("want error")
+	}
+	if fc.calls != 1 {
+		t.Fatalf("calls = %d; want 1", fc.calls)
+	}
+}
@@ -0,0 +1,84 @@
+package bnet
+
+import (
+	"context"
+	"errors"
+	"strconv"
+)
+
+// Tracer receives callbacks around API calls, so that tracing can be plugged in without this package
+// depending on a tracing SDK. See the otelbnet module for an OpenTelemetry adapter.
+type Tracer interface {
+	// Start is called before a call. The returned context is used for the call and passed to
+	// Finish, so it can carry a span. Start may add propagation headers to call.Header.
+	Start(ctx context.Context, call *Call) context.Context
+
+	// Finish is called after the call with its result filled in.
+	Finish(ctx context.Context, call *Call)
+}
+
+// Call describes an API call for a Tracer.
+type Call struct {
+	Operation string
+	Method    string
+	PathSpec  string
+
+	// MembershipType is the membershipType parameter of the call, or BungieMembershipType_None.
+	MembershipType BungieMembershipType
+
+	// Header holds headers to add to the request.
+	Header map[string]string
+
+	// StatusCode is the HTTP status, or 0 if no response was received.
+	StatusCode int
+
+	// ErrorCode is the PlatformErrorCodes of the response, or 0 if it is unknown.
+	ErrorCode PlatformErrorCodes
+
+	Err error
+}
+
+// WithTracer reports every call to t. Installed last, it traces whole calls including retries and
+// rate limiting; installed first, it traces each HTTP request.
+func (a *API) WithTracer(t Tracer) *API {
+	return a.WithInterceptorFunc(func(base Client, ctx context.Context, r ClientRequest, resp any) error {
+		call := &Call{
+			Operation: r.Operation,
+			Method:    r.Method,
+			PathSpec:  r.PathSpec,
+			Header:    map[string]string{},
+		}
+		if mt, err := strconv.Atoi(r.PathParams["membershipType"]); err == nil {
+			call.MembershipType = BungieMembershipType(mt)
+		}
+		ctx = t.Start(ctx, call)
+		if len(call.Header) > 0 {
+			headers := make(map[string]string, len(r.Headers)+len(call.Header))
+			for k, v := range r.Headers {
+				headers[k] = v
+			}
+			for k, v := range call.Header {
+				headers[k] = v
+			}
+			r.Headers = headers
+		}
+
+		err := base.Do(ctx, r, resp)
+		call.Err = err
+		if m, ok := resp.(interface{ Meta() ResponseMeta }); ok {
+			call.StatusCode = m.Meta().StatusCode
+		}
+		var hErr *HTTPError
+		var bErr *BungieError
+		switch {
+		case errors.As(err, &bErr):
+			call.ErrorCode = bErr.Code
+		case errors.As(err, &hErr):
+			call.StatusCode = hErr.Code
+		case err == nil:
+			call.ErrorCode = PlatformErrorCodes_Success
+		}
+		t.Finish(ctx, call)
+		return err
+	})
+}
@@ -0,0 +1,49 @@
+package bnet
+
+import (
+	"context"
+	"testing"
+)
+
+type recordingTracer struct {
+	calls []Call
+}
+
+type traceKey struct{}
+
+func (t *recordingTracer) Start(ctx context.Context, call *Call) context.Context {
+	call.Header["traceparent"] = "00-1-2-01"
+	return context.WithValue(ctx, traceKey{}, call.Operation)
+}
+
+func (t *recordingTracer) Finish(ctx context.Context, call *Call) {
+	if ctx.Value(traceKey{}) != call.Operation {
+		panic("context not propagated")
+	}
+	t.calls = append(t.calls, *call)
+}
+
+func TestTracer(t *testing.T) {
+	var header string
+	tracer := &recordingTracer{}
+	api := (&API{}).WithInterceptorFunc(func(_ Client, ctx context.Context, r ClientRequest, resp any) error {
+		header = r.Headers["traceparent"]
+		return &BungieError{Code: PlatformErrorCodes_DestinyAccountNotFound}
+	}).WithTracer(tracer)
+
+	api.Destiny2GetProfile(context.Background(), Destiny2GetProfileRequest{
+		MembershipType:      BungieMembershipType_TigerSteam,
+		DestinyMembershipID: 1,
+	})
+	if header != "00-1-2-01" {
+		t.Fatalf("traceparent = %q", header)
+	}
+	if len(tracer.calls) != 1 {
+		t.Fatalf("calls = %d; want 1", len(tracer.calls))
+	}
+	call := tracer.calls[0]
+	if call.Operation != "Destiny2.GetProfile" || call.MembershipType != BungieMembershipType_TigerSteam ||
+		call.ErrorCode != PlatformErrorCodes_DestinyAccountNotFound || call.Err == nil {
+		t.Fatalf("call = %+v", call)
+	}
+}
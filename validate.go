@@ -0,0 +1,26 @@
+package bnet
+
+import "fmt"
+
+// InvalidRequestError is returned by the Validate methods of requests, and by API methods before a
+// request with invalid parameters is sent.
+type InvalidRequestError struct {
+	Request string
+	Field   string
+	Reason  string
+}
+
+func (err *InvalidRequestError) Error() string {
+	return fmt.Sprintf("bnet: invalid %s: %s: %s", err.Request, err.Field, err.Reason)
+}
+
+func invalidRequest(request, field, reason string) error {
+	return &InvalidRequestError{Request: request, Field: field, Reason: reason}
+}
+
+// WithoutValidation returns an API that sends requests without calling their Validate method first.
+func (a *API) WithoutValidation() *API {
+	new := *a
+	new.skipValidation = true
+	return &new
+}
@@ -0,0 +1,37 @@
+package bnet
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	fc := &fakeClient{}
+	api := (&API{}).WithInterceptor(func(Client) Client { return fc })
+	ctx := context.Background()
+
+	_, err := api.Destiny2GetProfile(ctx, Destiny2GetProfileRequest{MembershipType: BungieMembershipType_TigerSteam})
+	var vErr *InvalidRequestError
+	if !errors.As(err, &vErr) || vErr.Field != "DestinyMembershipID" {
+		t.Fatalf("err = %v; want DestinyMembershipID required", err)
+	}
+	_, err = api.Destiny2GetProfile(ctx, Destiny2GetProfileRequest{
+		MembershipType:      BungieMembershipType_TigerSteam,
+		DestinyMembershipID: 1,
+		Components:          []ComponentType{ComponentType_Profiles, 42},
+	})
+	if !errors.As(err, &vErr) || vErr.Field != "Components" {
+		t.Fatalf("err = %v; want undefined component", err)
+	}
+	if fc.calls != 0 {
+		t.Fatalf("calls = %d; want 0", fc.calls)
+	}
+
+	if _, err := api.WithoutValidation().Destiny2GetProfile(ctx, Destiny2GetProfileRequest{}); err != nil {
+		t.Fatal(err)
+	}
+	if fc.calls != 1 {
+		t.Fatalf("calls = %d; want 1", fc.calls)
+	}
+}